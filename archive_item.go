@@ -0,0 +1,58 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type ArchiveItemRequest struct {
+	XMLName               struct{}       `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ArchiveItem"`
+	ArchiveSourceFolderId TargetFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ArchiveSourceFolderId"`
+	ItemIds               ItemIds        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemIds"`
+}
+
+type archiveItemResponseEnvelope struct {
+	XMLName xml.Name                `xml:"Envelope"`
+	Body    archiveItemResponseBody `xml:"Body"`
+}
+
+type archiveItemResponseBody struct {
+	ArchiveItemResponse ArchiveItemResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ArchiveItemResponse"`
+}
+
+type ArchiveItemResponse struct {
+	ResponseMessages ArchiveItemResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+// ArchiveItemResponseMessages holds one response message per item, in the order of the request
+type ArchiveItemResponseMessages struct {
+	ArchiveItemResponseMessage []Response `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ArchiveItemResponseMessage"`
+}
+
+// ArchiveItem moves the items of sourceFolderId to the same folder of the online archive
+// mailbox, creating it when missing. Items failing individually do not fail the call,
+// check the Err of each response message.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/archiveitem-operation
+func ArchiveItem(c Client, sourceFolderId TargetFolderId, itemIds []ItemId) (*ArchiveItemResponse, error) {
+	req := ArchiveItemRequest{
+		ArchiveSourceFolderId: sourceFolderId,
+		ItemIds:               ItemIds{ItemId: itemIds},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp archiveItemResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &soapResp.Body.ArchiveItemResponse, nil
+}
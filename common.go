@@ -1,6 +1,7 @@
 package ews
 
 import (
+	"encoding/xml"
 	"fmt"
 	"time"
 )
@@ -21,6 +22,14 @@ type Response struct {
 	Items         Items         `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Items"`
 }
 
+// Err returns a *ResponseError when the response has ResponseClass Error, nil otherwise
+func (r Response) Err() error {
+	if r.ResponseClass != ResponseClassError {
+		return nil
+	}
+	return &ResponseError{ResponseCode: r.ResponseCode, MessageText: r.MessageText}
+}
+
 type ServerVersionInfo struct {
 	MajorVersion     string `xml:"MajorVersion,attr"`
 	MinorVersion     string `xml:"MinorVersion,attr"`
@@ -56,14 +65,50 @@ type MessageXml struct {
 	ExceptionMessage    string `xml:"ExceptionMessage"`
 }
 
+// Names of the well-known folders, to be used as DistinguishedFolderId.Id
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/distinguishedfolderid
+const (
+	DistinguishedFolderIdRoot          = "root"
+	DistinguishedFolderIdMsgFolderRoot = "msgfolderroot"
+	DistinguishedFolderIdInbox         = "inbox"
+	DistinguishedFolderIdDrafts        = "drafts"
+	DistinguishedFolderIdOutbox        = "outbox"
+	DistinguishedFolderIdSentItems     = "sentitems"
+	DistinguishedFolderIdDeletedItems  = "deleteditems"
+	DistinguishedFolderIdJunkEmail     = "junkemail"
+	DistinguishedFolderIdCalendar      = "calendar"
+	DistinguishedFolderIdContacts      = "contacts"
+	DistinguishedFolderIdTasks         = "tasks"
+	DistinguishedFolderIdNotes         = "notes"
+	DistinguishedFolderIdJournal       = "journal"
+	DistinguishedFolderIdSearchFolders = "searchfolders"
+	DistinguishedFolderIdDirectory     = "directory"
+)
+
+// Names of the well-known folders of the online archive, addressed with the
+// primary mailbox, ex: NewDistinguishedFolderId(DistinguishedFolderIdArchiveInbox, &primaryAddress)
+const (
+	DistinguishedFolderIdArchiveRoot                      = "archiveroot"
+	DistinguishedFolderIdArchiveMsgFolderRoot             = "archivemsgfolderroot"
+	DistinguishedFolderIdArchiveInbox                     = "archiveinbox"
+	DistinguishedFolderIdArchiveDeletedItems              = "archivedeleteditems"
+	DistinguishedFolderIdArchiveRecoverableItemsRoot      = "archiverecoverableitemsroot"
+	DistinguishedFolderIdArchiveRecoverableItemsDeletions = "archiverecoverableitemsdeletions"
+	DistinguishedFolderIdArchiveRecoverableItemsPurges    = "archiverecoverableitemspurges"
+	DistinguishedFolderIdArchiveRecoverableItemsVersions  = "archiverecoverableitemsversions"
+)
+
 type DistinguishedFolderId struct {
 	// List of values:
 	// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/distinguishedfolderid
 	Id                 string   `xml:"Id,attr"`
+	ChangeKey          string   `xml:"ChangeKey,attr,omitempty"`
 	Mailbox            *Mailbox `xml:"http://schemas.microsoft.com/exchange/services/2006/types Mailbox,omitempty"`
 	IncludeHiddenItems *bool    `xml:"http://schemas.microsoft.com/exchange/services/2006/types IncludeHiddenItems,omitempty"`
 }
 
+// NewDistinguishedFolderId references a well-known folder, in the mailbox of email
+// when given (ex: a shared mailbox or a delegated calendar), in the caller's mailbox otherwise.
 func NewDistinguishedFolderId(id string, email *string) DistinguishedFolderId {
 	var mailbox *Mailbox
 	if email != nil {
@@ -78,8 +123,100 @@ func NewDistinguishedFolderId(id string, email *string) DistinguishedFolderId {
 	}
 }
 
-type ParentFolderId struct {
-	DistinguishedFolderId DistinguishedFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/types DistinguishedFolderId"`
+// FolderId identifies any folder by its Exchange id
+type FolderId struct {
+	Id        string `xml:"Id,attr"`
+	ChangeKey string `xml:"ChangeKey,attr,omitempty"`
+}
+
+// TargetFolderId references a single folder, either by FolderId or by DistinguishedFolderId.
+// Exactly one of both must be set.
+type TargetFolderId struct {
+	FolderId              *FolderId              `xml:"http://schemas.microsoft.com/exchange/services/2006/types FolderId,omitempty"`
+	DistinguishedFolderId *DistinguishedFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/types DistinguishedFolderId,omitempty"`
+}
+
+// NewTargetFolderId references the folder with the given id
+func NewTargetFolderId(folderId FolderId) TargetFolderId {
+	return TargetFolderId{FolderId: &folderId}
+}
+
+// NewDistinguishedTargetFolderId references a well-known folder, see NewDistinguishedFolderId
+func NewDistinguishedTargetFolderId(id string, email *string) TargetFolderId {
+	distinguishedFolderId := NewDistinguishedFolderId(id, email)
+	return TargetFolderId{DistinguishedFolderId: &distinguishedFolderId}
+}
+
+type (
+	ParentFolderId    = TargetFolderId
+	SavedItemFolderId = TargetFolderId
+)
+
+// FolderIds references one or more folders, by FolderId and/or DistinguishedFolderId. The folders
+// are sent in order, so that the response messages of an operation match them by index.
+type FolderIds []TargetFolderId
+
+// NewFolderIds groups the given folders into a FolderIds
+func NewFolderIds(targets ...TargetFolderId) FolderIds {
+	return FolderIds(targets)
+}
+
+// typesNamespace is the namespace of the FolderId and DistinguishedFolderId elements
+const typesNamespace = "http://schemas.microsoft.com/exchange/services/2006/types"
+
+// MarshalXML writes the FolderId and DistinguishedFolderId elements interleaved, in the order of f
+func (f FolderIds) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for i, target := range f {
+		var err error
+		switch {
+		case target.FolderId != nil:
+			err = e.EncodeElement(target.FolderId, xml.StartElement{Name: xml.Name{Space: typesNamespace, Local: "FolderId"}})
+		case target.DistinguishedFolderId != nil:
+			err = e.EncodeElement(target.DistinguishedFolderId, xml.StartElement{Name: xml.Name{Space: typesNamespace, Local: "DistinguishedFolderId"}})
+		default:
+			err = fmt.Errorf("folder %d has neither FolderId nor DistinguishedFolderId", i)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML reads the FolderId and DistinguishedFolderId elements in the order of the document
+func (f *FolderIds) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*f = nil
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			var target TargetFolderId
+			switch t.Name.Local {
+			case "FolderId":
+				target.FolderId = &FolderId{}
+				err = d.DecodeElement(target.FolderId, &t)
+			case "DistinguishedFolderId":
+				target.DistinguishedFolderId = &DistinguishedFolderId{}
+				err = d.DecodeElement(target.DistinguishedFolderId, &t)
+			default:
+				err = d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+			if target.FolderId != nil || target.DistinguishedFolderId != nil {
+				*f = append(*f, target)
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
 }
 
 type Persona struct {
@@ -134,31 +271,53 @@ type FieldURI struct {
 	FieldURI string `xml:"FieldURI,attr,omitempty"`
 }
 
+// IndexedFieldURI identifies one entry of a dictionary property, ex: the EmailAddress1 entry of contacts:EmailAddress
+type IndexedFieldURI struct {
+	FieldURI   string `xml:"FieldURI,attr"`
+	FieldIndex string `xml:"FieldIndex,attr"`
+}
+
 type (
 	PropertyType string
 	PropertyTag  string
 )
 
 const (
-	PropertyTypeBinary   PropertyType = "Binary"
-	PropertyTypeString   PropertyType = "String"
-	PropertyTypeInteger  PropertyType = "Integer"
-	PropertyTypeBoolean  PropertyType = "Boolean"
-	PropertyTypeDateTime PropertyType = "DateTime"
-	PropertyTypeDouble   PropertyType = "Double"
-	PropertyTypeSingle   PropertyType = "Single"
+	PropertyTypeBinary     PropertyType = "Binary"
+	PropertyTypeString     PropertyType = "String"
+	PropertyTypeInteger    PropertyType = "Integer"
+	PropertyTypeBoolean    PropertyType = "Boolean"
+	PropertyTypeDateTime   PropertyType = "DateTime"
+	PropertyTypeDouble     PropertyType = "Double"
+	PropertyTypeSystemTime PropertyType = "SystemTime"
+	PropertyTypeSingle     PropertyType = "Single"
 )
 
 const (
 	PropertyTagCategories        PropertyTag = "0x7c08"
 	PropertyTagInternetMessageId PropertyTag = "0x1035"
+	PropertyTagSenderSmtpAddress PropertyTag = "0x5d01"
+	// PropertyTagDeferredSendTime holds the time a sent message waits for in the Outbox
+	PropertyTagDeferredSendTime PropertyTag = "0x3fef"
+)
+
+type DistinguishedPropertySetId string
+
+const (
+	DistinguishedPropertySetIdPublicStrings   DistinguishedPropertySetId = "PublicStrings"
+	DistinguishedPropertySetIdInternetHeaders DistinguishedPropertySetId = "InternetHeaders"
+	DistinguishedPropertySetIdCommon          DistinguishedPropertySetId = "Common"
 )
 
+// ExtendedFieldURI identifies a MAPI property either by PropertyTag, or by name or id
+// within a property set, ex: the InternetHeaders set and an X- header as PropertyName
 type ExtendedFieldURI struct {
-	PropertyTag  PropertyTag  `xml:"PropertyTag,attr,omitempty"`
-	PropertyType PropertyType `xml:"PropertyType,attr,omitempty"`
-	PropertyName string       `xml:"PropertyName,attr,omitempty"`
-	PropertyId   string       `xml:"PropertyId,attr,omitempty"`
+	DistinguishedPropertySetId DistinguishedPropertySetId `xml:"DistinguishedPropertySetId,attr,omitempty"`
+	PropertySetId              string                     `xml:"PropertySetId,attr,omitempty"`
+	PropertyTag                PropertyTag                `xml:"PropertyTag,attr,omitempty"`
+	PropertyType               PropertyType               `xml:"PropertyType,attr,omitempty"`
+	PropertyName               string                     `xml:"PropertyName,attr,omitempty"`
+	PropertyId                 string                     `xml:"PropertyId,attr,omitempty"`
 }
 
 type BaseShape string
@@ -173,8 +332,11 @@ type ItemShape struct {
 	BaseShape                 BaseShape             `xml:"http://schemas.microsoft.com/exchange/services/2006/types BaseShape"`
 	IncludeMimeContent        bool                  `xml:"http://schemas.microsoft.com/exchange/services/2006/types IncludeMimeContent,omitempty"`
 	BodyType                  string                `xml:"http://schemas.microsoft.com/exchange/services/2006/types BodyType,omitempty"`
+	UniqueBodyType            string                `xml:"http://schemas.microsoft.com/exchange/services/2006/types UniqueBodyType,omitempty"`
+	NormalizedBodyType        string                `xml:"http://schemas.microsoft.com/exchange/services/2006/types NormalizedBodyType,omitempty"`
 	FilterHtmlContent         bool                  `xml:"http://schemas.microsoft.com/exchange/services/2006/types FilterHtmlContent,omitempty"`
 	ConvertHtmlCodePageToUTF8 bool                  `xml:"http://schemas.microsoft.com/exchange/services/2006/types ConvertHtmlCodePageToUTF8,omitempty"`
+	MaximumBodySize           *int                  `xml:"http://schemas.microsoft.com/exchange/services/2006/types MaximumBodySize,omitempty"` // in bytes, bodies above it are truncated
 	AdditionalProperties      *AdditionalProperties `xml:"http://schemas.microsoft.com/exchange/services/2006/types AdditionalProperties,omitempty"`
 }
 
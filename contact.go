@@ -0,0 +1,46 @@
+package ews
+
+import (
+	"time"
+)
+
+// Contact fields follow the order of the EWS schema (ItemType, then ContactItemType)
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/contact
+type Contact struct {
+	MimeContent        *MimeContent       `xml:"http://schemas.microsoft.com/exchange/services/2006/types MimeContent,omitempty"`
+	ItemId             *ItemId            `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemId,omitempty"`
+	ParentFolderId     *FolderId          `xml:"http://schemas.microsoft.com/exchange/services/2006/types ParentFolderId,omitempty"`
+	ItemClass          *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemClass,omitempty"`
+	Subject            *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Subject,omitempty"`
+	Body               *Body              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Body,omitempty"`
+	Categories         *Categories        `xml:"http://schemas.microsoft.com/exchange/services/2006/types Categories,omitempty"`
+	ExtendedProperties []ExtendedProperty `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
+
+	FileAs           *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types FileAs,omitempty"`
+	DisplayName      *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types DisplayName,omitempty"`
+	GivenName        *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types GivenName,omitempty"`
+	MiddleName       *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types MiddleName,omitempty"`
+	Nickname         *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types Nickname,omitempty"`
+	CompanyName      *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types CompanyName,omitempty"`
+	EmailAddresses   *EntryDictionary `xml:"http://schemas.microsoft.com/exchange/services/2006/types EmailAddresses,omitempty"`
+	PhoneNumbers     *EntryDictionary `xml:"http://schemas.microsoft.com/exchange/services/2006/types PhoneNumbers,omitempty"`
+	Birthday         *time.Time       `xml:"http://schemas.microsoft.com/exchange/services/2006/types Birthday,omitempty"`
+	BusinessHomePage *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types BusinessHomePage,omitempty"`
+	Department       *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types Department,omitempty"`
+	ImAddresses      *EntryDictionary `xml:"http://schemas.microsoft.com/exchange/services/2006/types ImAddresses,omitempty"`
+	JobTitle         *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types JobTitle,omitempty"`
+	Manager          *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types Manager,omitempty"`
+	OfficeLocation   *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types OfficeLocation,omitempty"`
+	Surname          *string          `xml:"http://schemas.microsoft.com/exchange/services/2006/types Surname,omitempty"`
+}
+
+// EntryDictionary holds the indexed values of a contact, ex: EmailAddresses keyed
+// EmailAddress1 to EmailAddress3 or PhoneNumbers keyed BusinessPhone, MobilePhone, ...
+type EntryDictionary struct {
+	Entry []DictionaryEntry `xml:"http://schemas.microsoft.com/exchange/services/2006/types Entry"`
+}
+
+type DictionaryEntry struct {
+	Key   string `xml:"Key,attr"`
+	Value string `xml:",chardata"`
+}
@@ -0,0 +1,61 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type CopyFolderRequest struct {
+	XMLName    struct{}       `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CopyFolder"`
+	ToFolderId TargetFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ToFolderId"`
+	FolderIds  FolderIds      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderIds"`
+}
+
+type copyFolderResponseEnvelope struct {
+	XMLName xml.Name               `xml:"Envelope"`
+	Body    copyFolderResponseBody `xml:"Body"`
+}
+
+type copyFolderResponseBody struct {
+	CopyFolderResponse CopyFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CopyFolderResponse"`
+}
+
+type CopyFolderResponse struct {
+	ResponseMessages CopyFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type CopyFolderResponseMessages struct {
+	CopyFolderResponseMessage []FolderInfoResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CopyFolderResponseMessage"`
+}
+
+// CopyFolder copies the folders into toFolderId, the response holds their new FolderId
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyfolder-operation
+func CopyFolder(c Client, toFolderId TargetFolderId, folderIds FolderIds) (*CopyFolderResponse, error) {
+	req := CopyFolderRequest{
+		ToFolderId: toFolderId,
+		FolderIds:  folderIds,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp copyFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.CopyFolderResponse.ResponseMessages.CopyFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.CopyFolderResponse, nil
+}
@@ -0,0 +1,13 @@
+package ews
+
+type CopyItemRequest struct {
+	XMLName struct{} `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CopyItem"`
+	BaseMoveCopyItem
+}
+
+// CopyItem copies the items into toFolderId, which may be in another mailbox. Items failing
+// individually do not fail the call, check the Err of each response message.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/copyitem-operation
+func CopyItem(c Client, itemIds []ItemId, toFolderId TargetFolderId) (*CopyItemResponse, error) {
+	return moveCopyItem(c, CopyItemRequest{BaseMoveCopyItem: newBaseMoveCopyItem(itemIds, toFolderId)})
+}
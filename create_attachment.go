@@ -0,0 +1,122 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type CreateAttachmentRequest struct {
+	XMLName      struct{}    `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CreateAttachment"`
+	ParentItemId ItemId      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ParentItemId"`
+	Attachments  Attachments `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Attachments"`
+}
+
+type createAttachmentResponseEnvelope struct {
+	XMLName xml.Name                     `xml:"Envelope"`
+	Body    createAttachmentResponseBody `xml:"Body"`
+}
+
+type createAttachmentResponseBody struct {
+	CreateAttachmentResponse CreateAttachmentResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CreateAttachmentResponse"`
+}
+
+type CreateAttachmentResponse struct {
+	ResponseMessages CreateAttachmentResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+// CreateAttachmentResponseMessages holds one response message per attachment, in the order of the request
+type CreateAttachmentResponseMessages struct {
+	CreateAttachmentResponseMessage []CreateAttachmentResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CreateAttachmentResponseMessage"`
+}
+
+type CreateAttachmentResponseMessage struct {
+	Response
+	Attachments CreatedAttachments `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Attachments"`
+}
+
+// CreatedAttachments are the attachments of a CreateAttachment response, which only carry their id
+type CreatedAttachments struct {
+	ItemAttachment []CreatedAttachment `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemAttachment"`
+	FileAttachment []CreatedAttachment `xml:"http://schemas.microsoft.com/exchange/services/2006/types FileAttachment"`
+}
+
+type CreatedAttachment struct {
+	AttachmentId *CreatedAttachmentId `xml:"http://schemas.microsoft.com/exchange/services/2006/types AttachmentId"`
+}
+
+// CreatedAttachmentId is the id of a created attachment along with the id of the item holding
+// the attachment, carrying its new ChangeKey
+type CreatedAttachmentId struct {
+	Id                string `xml:"Id,attr"`
+	RootItemId        string `xml:"RootItemId,attr,omitempty"`
+	RootItemChangeKey string `xml:"RootItemChangeKey,attr,omitempty"`
+}
+
+// AttachmentId returns the id to pass to GetAttachment or DeleteAttachment
+func (a CreatedAttachmentId) AttachmentId() AttachmentId {
+	return AttachmentId{Id: a.Id}
+}
+
+// AttachmentIds returns the ids of the created attachments, file and item attachments, in the
+// order of the request
+func (r *CreateAttachmentResponse) AttachmentIds() []CreatedAttachmentId {
+	var attachmentIds []CreatedAttachmentId
+	for _, message := range r.ResponseMessages.CreateAttachmentResponseMessage {
+		for _, attachment := range message.Attachments.FileAttachment {
+			if attachment.AttachmentId != nil {
+				attachmentIds = append(attachmentIds, *attachment.AttachmentId)
+			}
+		}
+		for _, attachment := range message.Attachments.ItemAttachment {
+			if attachment.AttachmentId != nil {
+				attachmentIds = append(attachmentIds, *attachment.AttachmentId)
+			}
+		}
+	}
+	return attachmentIds
+}
+
+// ParentItemId returns the id of the parent item carrying the ChangeKey it has after the last
+// attachment was created, nil when the server did not return it
+func (r *CreateAttachmentResponse) ParentItemId() *ItemId {
+	attachmentIds := r.AttachmentIds()
+	if len(attachmentIds) == 0 || attachmentIds[len(attachmentIds)-1].RootItemId == "" {
+		return nil
+	}
+	last := attachmentIds[len(attachmentIds)-1]
+	return &ItemId{Id: last.RootItemId, ChangeKey: last.RootItemChangeKey}
+}
+
+// CreateAttachment adds file or item attachments to an existing item, ex: a draft message, a
+// calendar item or a task, and returns the ids of the created attachments, or the error of the
+// first attachment that failed. Adding attachments changes the ChangeKey of the parent item,
+// the new one is returned in the RootItemChangeKey of the attachment ids, see ParentItemId.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/createattachment-operation
+func CreateAttachment(c Client, parentItemId ItemId, attachments Attachments) (*CreateAttachmentResponse, error) {
+	req := CreateAttachmentRequest{
+		ParentItemId: parentItemId,
+		Attachments:  attachments,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp createAttachmentResponseEnvelope
+	if err := xml.Unmarshal(bb, &soapResp); err != nil {
+		return nil, err
+	}
+
+	for _, message := range soapResp.Body.CreateAttachmentResponse.ResponseMessages.CreateAttachmentResponseMessage {
+		if err := message.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.CreateAttachmentResponse, nil
+}
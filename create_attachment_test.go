@@ -0,0 +1,71 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_CreateAttachmentRequest_ItemAttachment(t *testing.T) {
+	req := CreateAttachmentRequest{
+		ParentItemId: ItemId{Id: "AAMkTask", ChangeKey: "CK1"},
+		Attachments: Attachments{ItemAttachment: []ItemAttachment{{
+			Name:    "Original request",
+			Message: &Message{Subject: utils.Ptr("Please review")},
+		}}},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<CreateAttachment xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+  <ParentItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" Id="AAMkTask" ChangeKey="CK1"></ParentItemId>
+  <Attachments xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <ItemAttachment xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <Name xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Original request</Name>
+      <Message xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <Subject xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Please review</Subject>
+      </Message>
+    </ItemAttachment>
+  </Attachments>
+</CreateAttachment>`, string(xmlBytes))
+}
+
+func Test_unmarshal_CreateAttachmentResponse(t *testing.T) {
+	var soapResp createAttachmentResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <s:Body><m:CreateAttachmentResponse><m:ResponseMessages>
+    <m:CreateAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+      <m:Attachments><t:FileAttachment><t:AttachmentId Id="AAMkFile" RootItemId="AAMkEvent" RootItemChangeKey="CK2" /></t:FileAttachment></m:Attachments>
+    </m:CreateAttachmentResponseMessage>
+    <m:CreateAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+      <m:Attachments><t:ItemAttachment><t:AttachmentId Id="AAMkItem" RootItemId="AAMkEvent" RootItemChangeKey="CK3" /></t:ItemAttachment></m:Attachments>
+    </m:CreateAttachmentResponseMessage>
+  </m:ResponseMessages></m:CreateAttachmentResponse></s:Body>
+</s:Envelope>`), &soapResp))
+
+	resp := soapResp.Body.CreateAttachmentResponse
+	assert.Equal(t, []CreatedAttachmentId{
+		{Id: "AAMkFile", RootItemId: "AAMkEvent", RootItemChangeKey: "CK2"},
+		{Id: "AAMkItem", RootItemId: "AAMkEvent", RootItemChangeKey: "CK3"},
+	}, resp.AttachmentIds())
+	assert.Equal(t, &ItemId{Id: "AAMkEvent", ChangeKey: "CK3"}, resp.ParentItemId())
+}
+
+func Test_unmarshal_DeleteAttachmentResponse(t *testing.T) {
+	var soapResp deleteAttachmentResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages">
+  <s:Body><m:DeleteAttachmentResponse><m:ResponseMessages>
+    <m:DeleteAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+      <m:RootItemId RootItemId="AAMkEvent" RootItemChangeKey="CK4" />
+    </m:DeleteAttachmentResponseMessage>
+  </m:ResponseMessages></m:DeleteAttachmentResponse></s:Body>
+</s:Envelope>`), &soapResp))
+
+	resp := soapResp.Body.DeleteAttachmentResponse
+	assert.NoError(t, resp.ResponseMessages.DeleteAttachmentResponseMessage[0].Err())
+	assert.Equal(t, []ItemId{{Id: "AAMkEvent", ChangeKey: "CK4"}}, resp.RootItemIds())
+}
@@ -0,0 +1,61 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type CreateFolderRequest struct {
+	XMLName        struct{}       `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CreateFolder"`
+	ParentFolderId ParentFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ParentFolderId"`
+	Folders        Folders        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Folders"`
+}
+
+type createFolderResponseEnvelope struct {
+	XMLName xml.Name                 `xml:"Envelope"`
+	Body    createFolderResponseBody `xml:"Body"`
+}
+
+type createFolderResponseBody struct {
+	CreateFolderResponse CreateFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CreateFolderResponse"`
+}
+
+type CreateFolderResponse struct {
+	ResponseMessages CreateFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type CreateFolderResponseMessages struct {
+	CreateFolderResponseMessage []FolderInfoResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages CreateFolderResponseMessage"`
+}
+
+// CreateFolder creates the folders under parentFolderId, the response holds their new FolderId
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/createfolder-operation
+func CreateFolder(c Client, parentFolderId ParentFolderId, folders Folders) (*CreateFolderResponse, error) {
+	req := CreateFolderRequest{
+		ParentFolderId: parentFolderId,
+		Folders:        folders,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp createFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.CreateFolderResponse.ResponseMessages.CreateFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.CreateFolderResponse, nil
+}
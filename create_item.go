@@ -34,6 +34,30 @@ func NewCreateItemRequest(item any, config CreateItemRequestConfig) (*CreateItem
 				CalendarItem: []CalendarItem{item},
 			},
 		}, nil
+	case ReplyToItem:
+		return &CreateItemRequest{
+			MessageDisposition: config.MessageDisposition,
+			SavedItemFolderId:  config.SavedItemFolderId,
+			Items: Items{
+				ReplyToItem: []ReplyToItem{item},
+			},
+		}, nil
+	case ReplyAllToItem:
+		return &CreateItemRequest{
+			MessageDisposition: config.MessageDisposition,
+			SavedItemFolderId:  config.SavedItemFolderId,
+			Items: Items{
+				ReplyAllToItem: []ReplyAllToItem{item},
+			},
+		}, nil
+	case ForwardItem:
+		return &CreateItemRequest{
+			MessageDisposition: config.MessageDisposition,
+			SavedItemFolderId:  config.SavedItemFolderId,
+			Items: Items{
+				ForwardItem: []ForwardItem{item},
+			},
+		}, nil
 	}
 
 	return nil, errors.New("invalid item type")
@@ -42,23 +66,57 @@ func NewCreateItemRequest(item any, config CreateItemRequestConfig) (*CreateItem
 type Items struct {
 	Message      []Message      `xml:"http://schemas.microsoft.com/exchange/services/2006/types Message"`
 	CalendarItem []CalendarItem `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarItem"`
+	Contact      []Contact      `xml:"http://schemas.microsoft.com/exchange/services/2006/types Contact"`
+	Task         []Task         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Task"`
+
+	// response objects, only sent in CreateItem requests
+	ReplyToItem    []ReplyToItem    `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReplyToItem"`
+	ReplyAllToItem []ReplyAllToItem `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReplyAllToItem"`
+	ForwardItem    []ForwardItem    `xml:"http://schemas.microsoft.com/exchange/services/2006/types ForwardItem"`
 }
 
-type SavedItemFolderId struct {
-	DistinguishedFolderId DistinguishedFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/types DistinguishedFolderId"`
+// ItemIds returns the ids of the items of every type, skipping the items returned without id
+func (i Items) ItemIds() []ItemId {
+	var itemIds []ItemId
+	for _, message := range i.Message {
+		if message.ItemId != nil {
+			itemIds = append(itemIds, *message.ItemId)
+		}
+	}
+	for _, calendarItem := range i.CalendarItem {
+		if calendarItem.ItemId != nil {
+			itemIds = append(itemIds, *calendarItem.ItemId)
+		}
+	}
+	for _, contact := range i.Contact {
+		if contact.ItemId != nil {
+			itemIds = append(itemIds, *contact.ItemId)
+		}
+	}
+	for _, task := range i.Task {
+		if task.ItemId != nil {
+			itemIds = append(itemIds, *task.ItemId)
+		}
+	}
+	return itemIds
 }
 
+// Message fields follow the order of the EWS schema (ItemType, then MessageType),
+// which the server enforces for requests.
 type Message struct {
+	MimeContent                  *MimeContent            `xml:"http://schemas.microsoft.com/exchange/services/2006/types MimeContent,omitempty"`
 	ItemId                       *ItemId                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemId,omitempty"`
-	ParentFolderId               *ParentFolderId         `xml:"http://schemas.microsoft.com/exchange/services/2006/types ParentFolderId,omitempty"`
-	Categories                   *Categories             `xml:"http://schemas.microsoft.com/exchange/services/2006/types Categories,omitempty"`
+	ParentFolderId               *FolderId               `xml:"http://schemas.microsoft.com/exchange/services/2006/types ParentFolderId,omitempty"`
 	ItemClass                    *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemClass,omitempty"`
 	Subject                      *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Subject,omitempty"`
-	Sensitivity                  *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Sensitivity,omitempty"` // TODO: enum
+	Sensitivity                  *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Sensitivity,omitempty"`
 	Body                         *Body                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types Body,omitempty"`
+	Attachments                  *Attachments            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Attachments,omitempty"`
 	DateTimeReceived             *time.Time              `xml:"http://schemas.microsoft.com/exchange/services/2006/types DateTimeReceived,omitempty"`
 	Size                         *int                    `xml:"http://schemas.microsoft.com/exchange/services/2006/types Size,omitempty"`
+	Categories                   *Categories             `xml:"http://schemas.microsoft.com/exchange/services/2006/types Categories,omitempty"`
 	Importance                   *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Importance,omitempty"`
+	InReplyTo                    *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types InReplyTo,omitempty"`
 	IsSubmitted                  *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsSubmitted,omitempty"`
 	IsDraft                      *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsDraft,omitempty"`
 	IsFromMe                     *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFromMe,omitempty"`
@@ -71,7 +129,7 @@ type Message struct {
 	DisplayCc                    *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types DisplayCc,omitempty"`
 	DisplayTo                    *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types DisplayTo,omitempty"`
 	HasAttachments               *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types HasAttachments,omitempty"`
-	Attachments                  *Attachments            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Attachments,omitempty"`
+	ExtendedProperties           []ExtendedProperty      `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
 	Culture                      *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Culture,omitempty"`
 	EffectiveRights              *EffectiveRights        `xml:"http://schemas.microsoft.com/exchange/services/2006/types EffectiveRights,omitempty"`
 	LastModifiedName             *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types LastModifiedName,omitempty"`
@@ -79,21 +137,29 @@ type Message struct {
 	IsAssociated                 *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsAssociated,omitempty"`
 	WebClientReadFormQueryString *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types WebClientReadFormQueryString,omitempty"`
 	ConversationId               *ConversationId         `xml:"http://schemas.microsoft.com/exchange/services/2006/types ConversationId,omitempty"`
+	UniqueBody                   *Body                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types UniqueBody,omitempty"`
 	Flag                         *Flag                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types Flag,omitempty"`
 	InstanceKey                  *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types InstanceKey,omitempty"`
+	NormalizedBody               *Body                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types NormalizedBody,omitempty"`
 	EntityExtractionResult       *struct{}               `xml:"http://schemas.microsoft.com/exchange/services/2006/types EntityExtractionResult,omitempty"`
-	Sender                       *OneMailbox             `xml:"http://schemas.microsoft.com/exchange/services/2006/types Sender,omitempty"`
-	ToRecipients                 *XMailbox               `xml:"http://schemas.microsoft.com/exchange/services/2006/types ToRecipients,omitempty"`
-	IsReadReceiptRequested       *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsReadReceiptRequested,omitempty"`
-	ConversationIndex            *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types ConversationIndex,omitempty"`
-	ConversationTopic            *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types ConversationTopic,omitempty"`
-	From                         *OneMailbox             `xml:"http://schemas.microsoft.com/exchange/services/2006/types From,omitempty"`
-	InternetMessageId            *string                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types InternetMessageId,omitempty"`
-	IsRead                       *bool                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsRead,omitempty"`
-	ReceivedBy                   *OneMailbox             `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReceivedBy,omitempty"`
-	ReceivedRepresenting         *OneMailbox             `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReceivedRepresenting,omitempty"`
-
-	ExtendedProperties []ExtendedProperty `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
+	TextBody                     *Body                   `xml:"http://schemas.microsoft.com/exchange/services/2006/types TextBody,omitempty"`
+
+	Sender                     *OneMailbox `xml:"http://schemas.microsoft.com/exchange/services/2006/types Sender,omitempty"`
+	ToRecipients               *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ToRecipients,omitempty"`
+	CcRecipients               *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types CcRecipients,omitempty"`
+	BccRecipients              *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types BccRecipients,omitempty"`
+	IsReadReceiptRequested     *bool       `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsReadReceiptRequested,omitempty"`
+	IsDeliveryReceiptRequested *bool       `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsDeliveryReceiptRequested,omitempty"`
+	ConversationIndex          *string     `xml:"http://schemas.microsoft.com/exchange/services/2006/types ConversationIndex,omitempty"`
+	ConversationTopic          *string     `xml:"http://schemas.microsoft.com/exchange/services/2006/types ConversationTopic,omitempty"`
+	From                       *OneMailbox `xml:"http://schemas.microsoft.com/exchange/services/2006/types From,omitempty"`
+	InternetMessageId          *string     `xml:"http://schemas.microsoft.com/exchange/services/2006/types InternetMessageId,omitempty"`
+	IsRead                     *bool       `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsRead,omitempty"`
+	IsResponseRequested        *bool       `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsResponseRequested,omitempty"`
+	References                 *string     `xml:"http://schemas.microsoft.com/exchange/services/2006/types References,omitempty"`
+	ReplyTo                    *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReplyTo,omitempty"`
+	ReceivedBy                 *OneMailbox `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReceivedBy,omitempty"`
+	ReceivedRepresenting       *OneMailbox `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReceivedRepresenting,omitempty"`
 }
 
 func (m *Message) GetHeaders() (map[string]string, error) {
@@ -117,24 +183,84 @@ type Categories struct {
 	String []string `xml:"http://schemas.microsoft.com/exchange/services/2006/types String"`
 }
 
+// CalendarItem fields are optional so the same type serves creation and updates,
+// which send a single property at a time
 type CalendarItem struct {
-	Subject                    string      `xml:"t:Subject"`
-	Body                       Body        `xml:"t:Body"`
-	ReminderIsSet              bool        `xml:"t:ReminderIsSet"`
-	ReminderMinutesBeforeStart int         `xml:"t:ReminderMinutesBeforeStart"`
-	Start                      time.Time   `xml:"t:Start"`
-	End                        time.Time   `xml:"t:End"`
-	IsAllDayEvent              bool        `xml:"t:IsAllDayEvent"`
-	LegacyFreeBusyStatus       string      `xml:"t:LegacyFreeBusyStatus"`
-	Location                   string      `xml:"t:Location"`
-	RequiredAttendees          []Attendees `xml:"t:RequiredAttendees"`
-	OptionalAttendees          []Attendees `xml:"t:OptionalAttendees"`
-	Resources                  []Attendees `xml:"t:Resources"`
+	MimeContent                *MimeContent        `xml:"http://schemas.microsoft.com/exchange/services/2006/types MimeContent,omitempty"`
+	ItemId                     *ItemId             `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemId,omitempty"`
+	Subject                    string              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Subject"`
+	Body                       Body                `xml:"http://schemas.microsoft.com/exchange/services/2006/types Body"`
+	Categories                 *Categories         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Categories,omitempty"`
+	ReminderIsSet              bool                `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReminderIsSet"`
+	ReminderMinutesBeforeStart int                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReminderMinutesBeforeStart"`
+	ExtendedProperties         []ExtendedProperty  `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
+	Start                      time.Time           `xml:"http://schemas.microsoft.com/exchange/services/2006/types Start"`
+	End                        time.Time           `xml:"http://schemas.microsoft.com/exchange/services/2006/types End"`
+	IsAllDayEvent              bool                `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsAllDayEvent"`
+	LegacyFreeBusyStatus       string              `xml:"http://schemas.microsoft.com/exchange/services/2006/types LegacyFreeBusyStatus"`
+	Location                   string              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Location"`
+	RequiredAttendees          []Attendees         `xml:"http://schemas.microsoft.com/exchange/services/2006/types RequiredAttendees"`
+	OptionalAttendees          []Attendees         `xml:"http://schemas.microsoft.com/exchange/services/2006/types OptionalAttendees"`
+	Resources                  []Attendees         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Resources"`
+	StartTimeZone              *TimeZoneDefinition `xml:"http://schemas.microsoft.com/exchange/services/2006/types StartTimeZone,omitempty"`
+	EndTimeZone                *TimeZoneDefinition `xml:"http://schemas.microsoft.com/exchange/services/2006/types EndTimeZone,omitempty"`
+}
+
+// setTimeZones fills StartTimeZone and EndTimeZone from the locations of Start and End,
+// unless they are already set. Locations without a known Windows time zone are left unset.
+func (ci *CalendarItem) setTimeZones() {
+	if ci.StartTimeZone == nil {
+		ci.StartTimeZone, _ = NewTimeZoneDefinition(ci.Start.Location())
+	}
+	if ci.EndTimeZone == nil {
+		ci.EndTimeZone, _ = NewTimeZoneDefinition(ci.End.Location())
+	}
+}
+
+// CalendarItemUpdate is the calendar item of a SetItemField, where every field is optional
+// since an update only carries the property it sets.
+type CalendarItemUpdate struct {
+	Subject                    string              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Subject,omitempty"`
+	Body                       *Body               `xml:"http://schemas.microsoft.com/exchange/services/2006/types Body,omitempty"`
+	Categories                 *Categories         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Categories,omitempty"`
+	ReminderIsSet              *bool               `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReminderIsSet,omitempty"`
+	ReminderMinutesBeforeStart *int                `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReminderMinutesBeforeStart,omitempty"`
+	ExtendedProperties         []ExtendedProperty  `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
+	Start                      *time.Time          `xml:"http://schemas.microsoft.com/exchange/services/2006/types Start,omitempty"`
+	End                        *time.Time          `xml:"http://schemas.microsoft.com/exchange/services/2006/types End,omitempty"`
+	IsAllDayEvent              *bool               `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsAllDayEvent,omitempty"`
+	LegacyFreeBusyStatus       string              `xml:"http://schemas.microsoft.com/exchange/services/2006/types LegacyFreeBusyStatus,omitempty"`
+	Location                   string              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Location,omitempty"`
+	RequiredAttendees          []Attendees         `xml:"http://schemas.microsoft.com/exchange/services/2006/types RequiredAttendees,omitempty"`
+	OptionalAttendees          []Attendees         `xml:"http://schemas.microsoft.com/exchange/services/2006/types OptionalAttendees,omitempty"`
+	Resources                  []Attendees         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Resources,omitempty"`
+	StartTimeZone              *TimeZoneDefinition `xml:"http://schemas.microsoft.com/exchange/services/2006/types StartTimeZone,omitempty"`
+	EndTimeZone                *TimeZoneDefinition `xml:"http://schemas.microsoft.com/exchange/services/2006/types EndTimeZone,omitempty"`
 }
 
+const (
+	ImportanceLow    = "Low"
+	ImportanceNormal = "Normal"
+	ImportanceHigh   = "High"
+)
+
+const (
+	SensitivityNormal       = "Normal"
+	SensitivityPersonal     = "Personal"
+	SensitivityPrivate      = "Private"
+	SensitivityConfidential = "Confidential"
+)
+
+const (
+	BodyTypeBest = "Best"
+	BodyTypeHTML = "HTML"
+	BodyTypeText = "Text"
+)
+
 type Body struct {
-	BodyType string `xml:"BodyType,attr"`
-	Body     []byte `xml:",chardata"`
+	BodyType    string `xml:"BodyType,attr"`
+	IsTruncated bool   `xml:"IsTruncated,attr,omitempty"` // only set by the server, when MaximumBodySize is exceeded
+	Body        []byte `xml:",chardata"`
 }
 
 type OneMailbox struct {
@@ -146,7 +272,10 @@ type XMailbox struct {
 }
 
 type Mailbox struct {
+	Name         string `xml:"http://schemas.microsoft.com/exchange/services/2006/types Name,omitempty"`
 	EmailAddress string `xml:"http://schemas.microsoft.com/exchange/services/2006/types EmailAddress"`
+	RoutingType  string `xml:"http://schemas.microsoft.com/exchange/services/2006/types RoutingType,omitempty"` // SMTP by default
+	MailboxType  string `xml:"http://schemas.microsoft.com/exchange/services/2006/types MailboxType,omitempty"` // Mailbox, PublicDL, PrivateDL, Contact, PublicFolder, ...
 }
 
 type Attendee struct {
@@ -178,6 +307,41 @@ type CreateItemRequestConfig struct {
 	SavedItemFolderId  *SavedItemFolderId
 }
 
+// createItem sends a CreateItem request for item and returns the id of the created item,
+// nil when the server returns none, as for sent items
+func createItem(c Client, item any, config CreateItemRequestConfig) (*ItemId, error) {
+	createItemRequest, err := NewCreateItemRequest(item, config)
+	if err != nil {
+		return nil, err
+	}
+
+	xmlBytes, err := xml.MarshalIndent(createItemRequest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp createItemResponseBodyEnvelope
+	if err := xml.Unmarshal(bb, &soapResp); err != nil {
+		return nil, err
+	}
+
+	resp := soapResp.Body.CreateItemResponse.ResponseMessages.CreateItemResponseMessage
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+
+	itemIds := resp.Items.ItemIds()
+	if len(itemIds) == 0 {
+		return nil, nil
+	}
+	return &itemIds[0], nil
+}
+
 // CreateMessageItem
 // https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/createitem-operation-email-message
 func CreateMessageItem(c Client, message Message, config CreateItemRequestConfig) (*ItemId, error) {
@@ -202,8 +366,8 @@ func CreateMessageItem(c Client, message Message, config CreateItemRequestConfig
 	}
 
 	resp := soapResp.Body.CreateItemResponse.ResponseMessages.CreateItemResponseMessage
-	if resp.ResponseClass == ResponseClassError {
-		return nil, errors.New(resp.MessageText)
+	if err := resp.Err(); err != nil {
+		return nil, err
 	}
 
 	messages := resp.Items.Message
@@ -215,11 +379,15 @@ func CreateMessageItem(c Client, message Message, config CreateItemRequestConfig
 }
 
 // CreateCalendarItem
+// StartTimeZone and EndTimeZone default to the time zones of Start and End, so recurring
+// meetings keep their local time across DST changes.
 // https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/createitem-operation-calendar-item
 func CreateCalendarItem(c Client, calendarItem CalendarItem) error {
+	calendarItem.setTimeZones()
+
 	createItemRequest, err := NewCreateItemRequest(calendarItem, CreateItemRequestConfig{
 		MessageDisposition: MessageDispositionSendAndSaveCopy,
-		SavedItemFolderId:  &SavedItemFolderId{DistinguishedFolderId{Id: "calendar"}},
+		SavedItemFolderId:  &SavedItemFolderId{DistinguishedFolderId: &DistinguishedFolderId{Id: DistinguishedFolderIdCalendar}},
 	})
 	if err != nil {
 		return errors.Wrap(err, "failed to create create item request")
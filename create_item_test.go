@@ -18,8 +18,9 @@ func Test_marshal_CalendarItem(t *testing.T) {
 	attendees := make([]Attendees, 0)
 	attendees = append(attendees, Attendees{Attendee: attendee})
 
-	start, _ := time.Parse(time.RFC3339, "2006-11-02T14:00:00Z")
-	end, _ := time.Parse(time.RFC3339, "2006-11-02T15:00:00Z")
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2006, 11, 2, 14, 0, 0, 0, berlin)
+	end := time.Date(2006, 11, 2, 15, 0, 0, 0, berlin)
 
 	citem := &CalendarItem{
 		Subject: "Planning Meeting",
@@ -36,6 +37,7 @@ func Test_marshal_CalendarItem(t *testing.T) {
 		Location:                   "Conference Room 721",
 		RequiredAttendees:          attendees,
 	}
+	citem.setTimeZones()
 
 	xmlBytes, err := xml.MarshalIndent(citem, "", "  ")
 	if err != nil {
@@ -43,26 +45,28 @@ func Test_marshal_CalendarItem(t *testing.T) {
 	}
 
 	assert.Equal(t, `<CalendarItem>
-  <t:Subject>Planning Meeting</t:Subject>
-  <t:Body BodyType="Text">Plan the agenda for next week&#39;s meeting.</t:Body>
-  <t:ReminderIsSet>true</t:ReminderIsSet>
-  <t:ReminderMinutesBeforeStart>60</t:ReminderMinutesBeforeStart>
-  <t:Start>2006-11-02T14:00:00Z</t:Start>
-  <t:End>2006-11-02T15:00:00Z</t:End>
-  <t:IsAllDayEvent>false</t:IsAllDayEvent>
-  <t:LegacyFreeBusyStatus>Busy</t:LegacyFreeBusyStatus>
-  <t:Location>Conference Room 721</t:Location>
-  <t:RequiredAttendees>
-    <t:Attendee>
-      <t:Mailbox>
-        <t:EmailAddress>User1@example.com</t:EmailAddress>
-      </t:Mailbox>
-    </t:Attendee>
-    <t:Attendee>
-      <t:Mailbox>
-        <t:EmailAddress>User2@example.com</t:EmailAddress>
-      </t:Mailbox>
-    </t:Attendee>
-  </t:RequiredAttendees>
+  <Subject xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Planning Meeting</Subject>
+  <Body xmlns="http://schemas.microsoft.com/exchange/services/2006/types" BodyType="Text">Plan the agenda for next week&#39;s meeting.</Body>
+  <ReminderIsSet xmlns="http://schemas.microsoft.com/exchange/services/2006/types">true</ReminderIsSet>
+  <ReminderMinutesBeforeStart xmlns="http://schemas.microsoft.com/exchange/services/2006/types">60</ReminderMinutesBeforeStart>
+  <Start xmlns="http://schemas.microsoft.com/exchange/services/2006/types">2006-11-02T14:00:00+01:00</Start>
+  <End xmlns="http://schemas.microsoft.com/exchange/services/2006/types">2006-11-02T15:00:00+01:00</End>
+  <IsAllDayEvent xmlns="http://schemas.microsoft.com/exchange/services/2006/types">false</IsAllDayEvent>
+  <LegacyFreeBusyStatus xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Busy</LegacyFreeBusyStatus>
+  <Location xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Conference Room 721</Location>
+  <RequiredAttendees xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+    <Attendee xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">User1@example.com</EmailAddress>
+      </Mailbox>
+    </Attendee>
+    <Attendee xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">User2@example.com</EmailAddress>
+      </Mailbox>
+    </Attendee>
+  </RequiredAttendees>
+  <StartTimeZone xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="W. Europe Standard Time"></StartTimeZone>
+  <EndTimeZone xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="W. Europe Standard Time"></EndTimeZone>
 </CalendarItem>`, string(xmlBytes))
 }
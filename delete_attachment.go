@@ -0,0 +1,87 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type DeleteAttachmentRequest struct {
+	XMLName       struct{}             `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteAttachment"`
+	AttachmentIds RequestAttachmentIds `xml:"http://schemas.microsoft.com/exchange/services/2006/messages AttachmentIds"`
+}
+
+type RequestAttachmentIds struct {
+	AttachmentId []AttachmentId `xml:"http://schemas.microsoft.com/exchange/services/2006/types AttachmentId"`
+}
+
+type deleteAttachmentResponseEnvelope struct {
+	XMLName xml.Name                     `xml:"Envelope"`
+	Body    deleteAttachmentResponseBody `xml:"Body"`
+}
+
+type deleteAttachmentResponseBody struct {
+	DeleteAttachmentResponse DeleteAttachmentResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteAttachmentResponse"`
+}
+
+type DeleteAttachmentResponse struct {
+	ResponseMessages DeleteAttachmentResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+// DeleteAttachmentResponseMessages holds one response message per attachment, in the order of the request
+type DeleteAttachmentResponseMessages struct {
+	DeleteAttachmentResponseMessage []DeleteAttachmentResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteAttachmentResponseMessage"`
+}
+
+type DeleteAttachmentResponseMessage struct {
+	Response
+	RootItemId *RootItemId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages RootItemId"`
+}
+
+// RootItemId is the item holding a deleted attachment, with its new ChangeKey
+type RootItemId struct {
+	RootItemId        string `xml:"RootItemId,attr"`
+	RootItemChangeKey string `xml:"RootItemChangeKey,attr"`
+}
+
+// RootItemIds returns the ids of the items the attachments were removed from, carrying their
+// new ChangeKey, in the order of the request
+func (r *DeleteAttachmentResponse) RootItemIds() []ItemId {
+	var itemIds []ItemId
+	for _, message := range r.ResponseMessages.DeleteAttachmentResponseMessage {
+		if message.RootItemId != nil {
+			itemIds = append(itemIds, ItemId{Id: message.RootItemId.RootItemId, ChangeKey: message.RootItemId.RootItemChangeKey})
+		}
+	}
+	return itemIds
+}
+
+// DeleteAttachment removes attachments from their items and returns the ids of the items carrying
+// their new ChangeKey, or the error of the first attachment that failed.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteattachment-operation
+func DeleteAttachment(c Client, attachmentIds []AttachmentId) (*DeleteAttachmentResponse, error) {
+	req := DeleteAttachmentRequest{
+		AttachmentIds: RequestAttachmentIds{AttachmentId: attachmentIds},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp deleteAttachmentResponseEnvelope
+	if err := xml.Unmarshal(bb, &soapResp); err != nil {
+		return nil, err
+	}
+
+	for _, message := range soapResp.Body.DeleteAttachmentResponse.ResponseMessages.DeleteAttachmentResponseMessage {
+		if err := message.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.DeleteAttachmentResponse, nil
+}
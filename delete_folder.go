@@ -0,0 +1,70 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+// DisposalType is how folders and items are deleted
+type DisposalType string
+
+const (
+	DisposalTypeHardDelete         DisposalType = "HardDelete"
+	DisposalTypeSoftDelete         DisposalType = "SoftDelete"
+	DisposalTypeMoveToDeletedItems DisposalType = "MoveToDeletedItems"
+)
+
+type DeleteFolderRequest struct {
+	XMLName    struct{}     `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteFolder"`
+	DeleteType DisposalType `xml:"DeleteType,attr"`
+	FolderIds  FolderIds    `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderIds"`
+}
+
+type deleteFolderResponseEnvelope struct {
+	XMLName xml.Name                 `xml:"Envelope"`
+	Body    deleteFolderResponseBody `xml:"Body"`
+}
+
+type deleteFolderResponseBody struct {
+	DeleteFolderResponse DeleteFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteFolderResponse"`
+}
+
+type DeleteFolderResponse struct {
+	ResponseMessages DeleteFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type DeleteFolderResponseMessages struct {
+	DeleteFolderResponseMessage []Response `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteFolderResponseMessage"`
+}
+
+// DeleteFolder deletes the folders and everything they contain
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/deletefolder-operation
+func DeleteFolder(c Client, folderIds FolderIds, deleteType DisposalType) (*DeleteFolderResponse, error) {
+	req := DeleteFolderRequest{
+		DeleteType: deleteType,
+		FolderIds:  folderIds,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp deleteFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.DeleteFolderResponse.ResponseMessages.DeleteFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.DeleteFolderResponse, nil
+}
@@ -0,0 +1,88 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+// SendMeetingCancellations tells whether attendees are notified when a meeting is deleted
+type SendMeetingCancellations string
+
+const (
+	SendMeetingCancellationsSendToNone           SendMeetingCancellations = "SendToNone"
+	SendMeetingCancellationsSendOnlyToAll        SendMeetingCancellations = "SendOnlyToAll"
+	SendMeetingCancellationsSendToAllAndSaveCopy SendMeetingCancellations = "SendToAllAndSaveCopy"
+)
+
+// AffectedTaskOccurrences tells whether deleting a recurring task deletes the whole series
+type AffectedTaskOccurrences string
+
+const (
+	AffectedTaskOccurrencesAllOccurrences          AffectedTaskOccurrences = "AllOccurrences"
+	AffectedTaskOccurrencesSpecifiedOccurrenceOnly AffectedTaskOccurrences = "SpecifiedOccurrenceOnly"
+)
+
+type DeleteItemRequest struct {
+	XMLName                  struct{}                  `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteItem"`
+	DeleteType               DisposalType              `xml:"DeleteType,attr"`
+	SendMeetingCancellations *SendMeetingCancellations `xml:"SendMeetingCancellations,attr,omitempty"`
+	AffectedTaskOccurrences  *AffectedTaskOccurrences  `xml:"AffectedTaskOccurrences,attr,omitempty"`
+	SuppressReadReceipts     *bool                     `xml:"SuppressReadReceipts,attr,omitempty"`
+	ItemIds                  ItemIds                   `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemIds"`
+}
+
+// DeleteItemRequestConfig holds the optional attributes of DeleteItem, SendMeetingCancellations
+// is required when deleting calendar items and AffectedTaskOccurrences when deleting tasks
+type DeleteItemRequestConfig struct {
+	SendMeetingCancellations *SendMeetingCancellations
+	AffectedTaskOccurrences  *AffectedTaskOccurrences
+	SuppressReadReceipts     *bool
+}
+
+type deleteItemResponseEnvelope struct {
+	XMLName xml.Name               `xml:"Envelope"`
+	Body    deleteItemResponseBody `xml:"Body"`
+}
+
+type deleteItemResponseBody struct {
+	DeleteItemResponse DeleteItemResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteItemResponse"`
+}
+
+type DeleteItemResponse struct {
+	ResponseMessages DeleteItemResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+// DeleteItemResponseMessages holds one response message per item, in the order of the request
+type DeleteItemResponseMessages struct {
+	DeleteItemResponseMessage []Response `xml:"http://schemas.microsoft.com/exchange/services/2006/messages DeleteItemResponseMessage"`
+}
+
+// DeleteItem deletes the items in a single request. Items failing individually do not
+// fail the call, check the Err of each response message.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/deleteitem-operation
+func DeleteItem(c Client, itemIds []ItemId, deleteType DisposalType, config DeleteItemRequestConfig) (*DeleteItemResponse, error) {
+	req := DeleteItemRequest{
+		DeleteType:               deleteType,
+		SendMeetingCancellations: config.SendMeetingCancellations,
+		AffectedTaskOccurrences:  config.AffectedTaskOccurrences,
+		SuppressReadReceipts:     config.SuppressReadReceipts,
+		ItemIds:                  ItemIds{ItemId: itemIds},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp deleteItemResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &soapResp.Body.DeleteItemResponse, nil
+}
@@ -0,0 +1,56 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_DeleteItemRequest(t *testing.T) {
+	req := DeleteItemRequest{
+		DeleteType:               DisposalTypeMoveToDeletedItems,
+		SendMeetingCancellations: utils.Ptr(SendMeetingCancellationsSendToAllAndSaveCopy),
+		SuppressReadReceipts:     utils.Ptr(true),
+		ItemIds:                  ItemIds{ItemId: []ItemId{{Id: "AAMkA1"}, {Id: "AAMkA2", ChangeKey: "CQAAAB"}}},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<DeleteItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" DeleteType="MoveToDeletedItems" SendMeetingCancellations="SendToAllAndSaveCopy" SuppressReadReceipts="true">
+  <ItemIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkA1"></ItemId>
+    <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkA2" ChangeKey="CQAAAB"></ItemId>
+  </ItemIds>
+</DeleteItem>`, string(xmlBytes))
+}
+
+func Test_unmarshal_DeleteItemResponse(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:DeleteItemResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages">
+      <m:ResponseMessages>
+        <m:DeleteItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+        </m:DeleteItemResponseMessage>
+        <m:DeleteItemResponseMessage ResponseClass="Error">
+          <m:MessageText>The specified object was not found in the store.</m:MessageText>
+          <m:ResponseCode>ErrorItemNotFound</m:ResponseCode>
+        </m:DeleteItemResponseMessage>
+      </m:ResponseMessages>
+    </m:DeleteItemResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp deleteItemResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(soapResp), &resp))
+
+	messages := resp.Body.DeleteItemResponse.ResponseMessages.DeleteItemResponseMessage
+	require.Len(t, messages, 2)
+	assert.NoError(t, messages[0].Err())
+	assert.True(t, IsResponseCode(messages[1].Err(), "ErrorItemNotFound"))
+}
@@ -0,0 +1,64 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type EmptyFolderRequest struct {
+	XMLName          struct{}     `xml:"http://schemas.microsoft.com/exchange/services/2006/messages EmptyFolder"`
+	DeleteType       DisposalType `xml:"DeleteType,attr"`
+	DeleteSubFolders bool         `xml:"DeleteSubFolders,attr"`
+	FolderIds        FolderIds    `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderIds"`
+}
+
+type emptyFolderResponseEnvelope struct {
+	XMLName xml.Name                `xml:"Envelope"`
+	Body    emptyFolderResponseBody `xml:"Body"`
+}
+
+type emptyFolderResponseBody struct {
+	EmptyFolderResponse EmptyFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages EmptyFolderResponse"`
+}
+
+type EmptyFolderResponse struct {
+	ResponseMessages EmptyFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type EmptyFolderResponseMessages struct {
+	EmptyFolderResponseMessage []Response `xml:"http://schemas.microsoft.com/exchange/services/2006/messages EmptyFolderResponseMessage"`
+}
+
+// EmptyFolder deletes the items of the folders, and their sub folders when deleteSubFolders is set,
+// ex: EmptyFolder(c, NewFolderIds(NewDistinguishedTargetFolderId(DistinguishedFolderIdDeletedItems, nil)), DisposalTypeHardDelete, true)
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/emptyfolder-operation
+func EmptyFolder(c Client, folderIds FolderIds, deleteType DisposalType, deleteSubFolders bool) (*EmptyFolderResponse, error) {
+	req := EmptyFolderRequest{
+		DeleteType:       deleteType,
+		DeleteSubFolders: deleteSubFolders,
+		FolderIds:        folderIds,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp emptyFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.EmptyFolderResponse.ResponseMessages.EmptyFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.EmptyFolderResponse, nil
+}
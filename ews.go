@@ -3,6 +3,7 @@ package ews
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -17,6 +18,8 @@ const (
 		xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/">
   		<soap:Header>
     		<RequestServerVersion xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Version="Exchange2013_SP1" />
+`
+	soapHeaderEnd = `
   		</soap:Header>
   		<soap:Body>
 `
@@ -28,6 +31,9 @@ type Config struct {
 	Dump    bool
 	NTLM    bool
 	SkipTLS bool
+	// TimeZoneContext, when set, is sent with every request so the server
+	// interprets and returns times in that time zone
+	TimeZoneContext *TimeZoneDefinition
 }
 
 type Client interface {
@@ -63,6 +69,14 @@ func NewClient(ewsAddr, username, password string, config *Config) Client {
 func (c *client) SendAndReceive(body []byte) ([]byte, error) {
 
 	bb := []byte(soapStart)
+	if c.config != nil && c.config.TimeZoneContext != nil {
+		tzc, err := xml.Marshal(TimeZoneContext{TimeZoneDefinition: *c.config.TimeZoneContext})
+		if err != nil {
+			return nil, err
+		}
+		bb = append(bb, tzc...)
+	}
+	bb = append(bb, soapHeaderEnd...)
 	bb = append(bb, body...)
 	bb = append(bb, soapEnd...)
 
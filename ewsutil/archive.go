@@ -0,0 +1,83 @@
+package ewsutil
+
+import (
+	"time"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// archiveBatchSize is the number of items archived per ArchiveItem request
+const archiveBatchSize = 100
+
+// ArchiveOlderThan moves the items of folderId received more than days ago to the online
+// archive and returns how many were archived. Items failing to archive are skipped and
+// reported in the returned error once the folder has been processed.
+func ArchiveOlderThan(c ews.Client, folderId ews.TargetFolderId, days int) (int, error) {
+	cutoff := time.Now().UTC().AddDate(0, 0, -days)
+
+	var archived, failed int
+	var lastErr error
+	for {
+		findItemResponse, err := ews.FindItemInFolders(c, ews.NewFolderIds(folderId), ews.FindItemRequestConfig{
+			Traversal: utils.Ptr(ews.FindItemTraversalShallow),
+			BaseShape: utils.Ptr(ews.BaseShapeIdOnly),
+			Restriction: &ews.Restriction{
+				IsLessThan: &ews.IsLessThan{
+					FieldURI:           &ews.FieldURI{FieldURI: "item:DateTimeReceived"},
+					FieldURIOrConstant: ews.NewConstant(cutoff.Format(time.RFC3339)),
+				},
+			},
+			// archived items leave the folder and the ones that failed stay first, so skipping
+			// the failed ones starts the page at the first item not processed yet
+			SortOrder: &ews.SortOrder{FieldOrder: []ews.FieldOrder{{
+				Order:    ews.SortDirectionAscending,
+				FieldURI: &ews.FieldURI{FieldURI: "item:DateTimeReceived"},
+			}}},
+			IndexedPageItemView: &ews.IndexedPageItemView{
+				MaxEntriesReturned: archiveBatchSize,
+				Offset:             failed,
+				BasePoint:          ews.BasePointBeginning,
+			},
+		})
+		if err != nil {
+			return archived, errors.Wrap(err, "failed to find items")
+		}
+
+		message := findItemResponse.ResponseMessages.FindItemResponseMessage[0]
+
+		itemIds := message.RootFolder.Items.ItemIds()
+		if len(itemIds) == 0 {
+			break
+		}
+
+		archiveItemResponse, err := ews.ArchiveItem(c, folderId, itemIds)
+		if err != nil {
+			return archived, errors.Wrap(err, "failed to archive items")
+		}
+
+		// each pass archives or skips every item of the page, or the loop would not advance
+		messages := archiveItemResponse.ResponseMessages.ArchiveItemResponseMessage
+		if len(messages) != len(itemIds) {
+			return archived, errors.Errorf("expected %d response messages, got %d", len(itemIds), len(messages))
+		}
+		for _, resp := range messages {
+			if err := resp.Err(); err != nil {
+				failed++
+				lastErr = err
+				continue
+			}
+			archived++
+		}
+
+		if message.RootFolder.IncludesLastItemInRange {
+			break
+		}
+	}
+
+	if failed > 0 {
+		return archived, errors.Wrapf(lastErr, "failed to archive %d items", failed)
+	}
+	return archived, nil
+}
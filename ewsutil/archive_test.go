@@ -0,0 +1,62 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findItemResponse(itemIds ...string) string {
+	var items string
+	for _, id := range itemIds {
+		items += `<t:Message><t:ItemId Id="` + id + `" ChangeKey="CK1" /></t:Message>`
+	}
+	return soapEnvelope(`<m:FindItemResponse><m:ResponseMessages>
+  <m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:RootFolder IncludesLastItemInRange="true"><t:Items>` + items + `</t:Items></m:RootFolder>
+  </m:FindItemResponseMessage>
+</m:ResponseMessages></m:FindItemResponse>`)
+}
+
+func Test_ArchiveOlderThan(t *testing.T) {
+	firstPage := soapEnvelope(`<m:FindItemResponse><m:ResponseMessages>
+  <m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:RootFolder IncludesLastItemInRange="false"><t:Items>
+      <t:Message><t:ItemId Id="AAMkOld1" /></t:Message>
+      <t:Message><t:ItemId Id="AAMkOld2" /></t:Message>
+    </t:Items></m:RootFolder>
+  </m:FindItemResponseMessage>
+</m:ResponseMessages></m:FindItemResponse>`)
+	inboxId := ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdInbox, nil)
+
+	c := &stubClient{responses: []string{
+		firstPage,
+		soapEnvelope(`<m:ArchiveItemResponse><m:ResponseMessages>
+  <m:ArchiveItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorAccessDenied</m:ResponseCode></m:ArchiveItemResponseMessage>
+  <m:ArchiveItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:ArchiveItemResponseMessage>
+</m:ResponseMessages></m:ArchiveItemResponse>`),
+		findItemResponse(),
+	}}
+
+	archived, err := ArchiveOlderThan(c, inboxId, 30)
+	assert.Equal(t, 1, archived)
+	require.Error(t, err)
+	assert.True(t, ews.IsResponseCode(err, "ErrorAccessDenied"))
+
+	require.Len(t, c.requests, 3)
+	assert.Contains(t, c.requests[0], `<FieldOrder xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Order="Ascending">
+      <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="item:DateTimeReceived"></FieldURI>`)
+	assert.Contains(t, c.requests[0], `Offset="0"`)
+	// the item that failed stays first and is skipped
+	assert.Contains(t, c.requests[2], `Offset="1"`)
+
+	// a response missing items would loop on the same page
+	c = &stubClient{responses: []string{
+		firstPage,
+		soapEnvelope(`<m:ArchiveItemResponse><m:ResponseMessages></m:ResponseMessages></m:ArchiveItemResponse>`),
+	}}
+	_, err = ArchiveOlderThan(c, inboxId, 30)
+	assert.EqualError(t, err, "expected 2 response messages, got 0")
+}
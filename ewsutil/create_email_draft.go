@@ -36,6 +36,6 @@ func CreateEmailDraft(c ews.Client, to []string, subject, body string, attachmen
 
 	return ews.CreateMessageItem(c, m, ews.CreateItemRequestConfig{
 		MessageDisposition: ews.MessageDispositionSaveOnly,
-		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: ews.DistinguishedFolderId{Id: "drafts"}},
+		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: &ews.DistinguishedFolderId{Id: "drafts"}},
 	})
 }
@@ -0,0 +1,39 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// DeleteItems deletes the items in one request and returns the error of each item,
+// in the order of itemIds, nil for the items deleted
+func DeleteItems(c ews.Client, itemIds []ews.ItemId, deleteType ews.DisposalType) ([]error, error) {
+	deleteItemResponse, err := ews.DeleteItem(c, itemIds, deleteType, ews.DeleteItemRequestConfig{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to delete items")
+	}
+
+	messages := deleteItemResponse.ResponseMessages.DeleteItemResponseMessage
+	if len(messages) != len(itemIds) {
+		return nil, errors.Errorf("expected %d response messages, got %d", len(itemIds), len(messages))
+	}
+
+	itemErrors := make([]error, len(messages))
+	for i, message := range messages {
+		itemErrors[i] = message.Err()
+	}
+
+	return itemErrors, nil
+}
+
+// DeleteDraft permanently deletes a draft, ex: one created by CreateEmailDraft
+func DeleteDraft(c ews.Client, itemId *ews.ItemId) error {
+	itemErrors, err := DeleteItems(c, []ews.ItemId{{Id: itemId.Id}}, ews.DisposalTypeHardDelete)
+	if err != nil {
+		return err
+	}
+	if itemErrors[0] != nil {
+		return errors.Wrap(itemErrors[0], "failed to delete draft")
+	}
+	return nil
+}
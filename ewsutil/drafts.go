@@ -0,0 +1,134 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// DraftUpdate holds the changes of UpdateDraft, the nil fields are left unchanged and an
+// empty, non nil, list of recipients removes them
+type DraftUpdate struct {
+	Subject *string
+	Body    *ews.Body
+	To      []string
+	Cc      []string
+	Bcc     []string
+}
+
+// ListDrafts returns a page of the drafts folder, RootFolder.IncludesLastItemInRange tells
+// whether more pages follow
+func ListDrafts(c ews.Client, offset, pageSize int) (*ews.RootFolder, error) {
+	findItemResponse, err := ews.FindItem(c, ews.DistinguishedFolderIdDrafts, ews.FindItemRequestConfig{
+		Traversal: utils.Ptr(ews.FindItemTraversalShallow),
+		BaseShape: utils.Ptr(ews.BaseShapeDefault),
+		IndexedPageItemView: &ews.IndexedPageItemView{
+			MaxEntriesReturned: pageSize,
+			Offset:             offset,
+			BasePoint:          ews.BasePointBeginning,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find drafts")
+	}
+
+	return &findItemResponse.ResponseMessages.FindItemResponseMessage[0].RootFolder, nil
+}
+
+// UpdateDraft changes the subject, body or recipients of a draft and returns its id carrying
+// the new ChangeKey, or no ChangeKey when the server does not return it. Pass the ChangeKey in
+// itemId to fail when the draft changed meanwhile.
+func UpdateDraft(c ews.Client, itemId ews.ItemId, update DraftUpdate) (*ews.ItemId, error) {
+	b := ews.NewUpdateItemBuilder().Item(itemId, ews.ItemTypeMessage)
+	if update.Subject != nil {
+		b.Set("item:Subject", *update.Subject)
+	}
+	if update.Body != nil {
+		b.Set("item:Body", update.Body)
+	}
+	for _, recipients := range []struct {
+		fieldURI  string
+		addresses []string
+	}{
+		{"message:ToRecipients", update.To},
+		{"message:CcRecipients", update.Cc},
+		{"message:BccRecipients", update.Bcc},
+	} {
+		if recipients.addresses == nil {
+			continue
+		}
+		if len(recipients.addresses) == 0 {
+			b.Delete(recipients.fieldURI)
+			continue
+		}
+		mailboxes, err := parseAddressList(recipients.addresses)
+		if err != nil {
+			return nil, err
+		}
+		b.Set(recipients.fieldURI, &ews.XMailbox{Mailbox: mailboxes})
+	}
+
+	newItemIds, err := b.Send(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update draft")
+	}
+	if len(newItemIds) != 1 {
+		return nil, errors.Errorf("expected 1 updated item, got %d", len(newItemIds))
+	}
+	if newItemIds[0] == nil {
+		// the server may leave the updated draft out of the response, its new ChangeKey is unknown
+		return &ews.ItemId{Id: itemId.Id}, nil
+	}
+	return newItemIds[0], nil
+}
+
+// AddDraftAttachments attaches files to a draft and returns the ids of the attachments,
+// in the order of attachments, their RootItemChangeKey is the new ChangeKey of the draft
+func AddDraftAttachments(c ews.Client, itemId ews.ItemId, attachments ...ews.FileAttachment) ([]ews.CreatedAttachmentId, error) {
+	createAttachmentResponse, err := ews.CreateAttachment(c, itemId, ews.Attachments{FileAttachment: attachments})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add attachments")
+	}
+
+	attachmentIds := createAttachmentResponse.AttachmentIds()
+	if len(attachmentIds) != len(attachments) {
+		return nil, errors.Errorf("expected %d attachment ids, got %d", len(attachments), len(attachmentIds))
+	}
+	return attachmentIds, nil
+}
+
+// RemoveDraftAttachments removes attachments from a draft and returns the id of the draft
+// carrying its new ChangeKey
+func RemoveDraftAttachments(c ews.Client, attachmentIds ...ews.AttachmentId) (*ews.ItemId, error) {
+	deleteAttachmentResponse, err := ews.DeleteAttachment(c, attachmentIds)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to remove attachments")
+	}
+
+	rootItemIds := deleteAttachmentResponse.RootItemIds()
+	if len(rootItemIds) == 0 {
+		return nil, errors.New("missing draft id in response")
+	}
+	return &rootItemIds[len(rootItemIds)-1], nil
+}
+
+// SendDraft sends a draft and keeps a copy in the sent items folder. Unlike the sends of
+// SendEmail, the draft is kept when the server rejects it, so that the user can fix it.
+func SendDraft(c ews.Client, itemId ews.ItemId) error {
+	if _, err := ews.SendItem(c, itemId, true); err != nil {
+		return errors.Wrap(err, "failed to send draft")
+	}
+	return nil
+}
+
+func parseAddressList(addresses []string) ([]ews.Mailbox, error) {
+	var mailboxes []ews.Mailbox
+	for _, address := range addresses {
+		parsed, err := ParseAddresses(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address %q", address)
+		}
+		mailboxes = append(mailboxes, parsed...)
+	}
+	return mailboxes, nil
+}
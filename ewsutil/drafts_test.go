@@ -0,0 +1,83 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ListDrafts(t *testing.T) {
+	c := &stubClient{responses: []string{findItemResponse("AAMkDraft1", "AAMkDraft2")}}
+
+	rootFolder, err := ListDrafts(c, 20, 10)
+	require.NoError(t, err)
+	require.Len(t, rootFolder.Items.Message, 2)
+	assert.True(t, rootFolder.IncludesLastItemInRange)
+	assert.Contains(t, c.requests[0], `MaxEntriesReturned="10" Offset="20" BasePoint="Beginning"`)
+	assert.Contains(t, c.requests[0], `Id="drafts"`)
+}
+
+func Test_UpdateDraft(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK2" /></t:Message></m:Items>
+  </m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+	}}
+
+	itemId, err := UpdateDraft(c, ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK1"}, DraftUpdate{
+		Subject: utils.Ptr("Quote, revised"),
+		To:      []string{"Jane Doe <jane@contoso.com>"},
+		Cc:      []string{},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK2"}, itemId)
+
+	req := c.requests[0]
+	assert.Contains(t, req, `<ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkDraft" ChangeKey="CK1"></ItemId>`)
+	assert.Contains(t, req, `>Quote, revised</Subject>`)
+	assert.Contains(t, req, `<Name xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Jane Doe</Name>`)
+	assert.Contains(t, req, `<FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="message:CcRecipients"></FieldURI>`)
+	assert.NotContains(t, req, `message:BccRecipients`)
+
+	_, err = UpdateDraft(c, ews.ItemId{Id: "AAMkDraft"}, DraftUpdate{To: []string{"not an address"}})
+	assert.Error(t, err)
+
+	c.responses = []string{soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode><m:Items /></m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`)}
+	itemId, err = UpdateDraft(c, ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK2"}, DraftUpdate{Subject: utils.Ptr("Quote")})
+	require.NoError(t, err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkDraft"}, itemId)
+}
+
+func Test_DraftAttachments(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:CreateAttachmentResponse><m:ResponseMessages>
+  <m:CreateAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Attachments><t:FileAttachment><t:AttachmentId Id="AAMkAttachment" RootItemId="AAMkDraft" RootItemChangeKey="CK2" /></t:FileAttachment></m:Attachments>
+  </m:CreateAttachmentResponseMessage>
+</m:ResponseMessages></m:CreateAttachmentResponse>`),
+		soapEnvelope(`<m:DeleteAttachmentResponse><m:ResponseMessages>
+  <m:DeleteAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:RootItemId RootItemId="AAMkDraft" RootItemChangeKey="CK3" />
+  </m:DeleteAttachmentResponseMessage>
+</m:ResponseMessages></m:DeleteAttachmentResponse>`),
+	}}
+
+	attachmentIds, err := AddDraftAttachments(c, ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK1"}, ews.FileAttachment{Name: "quote.pdf", Content: "JVBERi0="})
+	require.NoError(t, err)
+	require.Len(t, attachmentIds, 1)
+	assert.Equal(t, ews.CreatedAttachmentId{Id: "AAMkAttachment", RootItemId: "AAMkDraft", RootItemChangeKey: "CK2"}, attachmentIds[0])
+
+	itemId, err := RemoveDraftAttachments(c, attachmentIds[0].AttachmentId())
+	require.NoError(t, err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK3"}, itemId)
+	assert.Contains(t, c.requests[1], `<AttachmentIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <AttachmentId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkAttachment"></AttachmentId>
+  </AttachmentIds>`)
+}
@@ -0,0 +1,81 @@
+package ewsutil
+
+import (
+	"bytes"
+	"io"
+	"net/mail"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// ExportEML writes the MIME content of an item to w, in the .eml format mail clients open.
+// Messages, calendar items, contacts and tasks all have a MIME form.
+func ExportEML(c ews.Client, itemId ews.ItemId, w io.Writer) error {
+	mimeContent, err := getMimeContent(c, itemId)
+	if err != nil {
+		return err
+	}
+
+	raw, err := mimeContent.Bytes()
+	if err != nil {
+		return errors.Wrap(err, "failed to decode mime content")
+	}
+	if _, err := w.Write(raw); err != nil {
+		return errors.Wrap(err, "failed to write eml")
+	}
+	return nil
+}
+
+// GetMailMessage returns an item parsed as a net/mail message
+func GetMailMessage(c ews.Client, itemId ews.ItemId) (*mail.Message, error) {
+	mimeContent, err := getMimeContent(c, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := mimeContent.Bytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode mime content")
+	}
+	m, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse mime content")
+	}
+	return m, nil
+}
+
+// getMimeContent returns the MIME content of the item, whatever its type
+func getMimeContent(c ews.Client, itemId ews.ItemId) (*ews.MimeContent, error) {
+	getItemResponse, err := ews.GetItem(c, itemId, ews.GetItemRequestConfig{
+		ItemShape: &ews.ItemShape{
+			BaseShape:          ews.BaseShapeIdOnly,
+			IncludeMimeContent: true,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get item")
+	}
+
+	items := getItemResponse.ResponseMessages.GetItemResponseMessage.Items
+	var mimeContents []*ews.MimeContent
+	for _, message := range items.Message {
+		mimeContents = append(mimeContents, message.MimeContent)
+	}
+	for _, calendarItem := range items.CalendarItem {
+		mimeContents = append(mimeContents, calendarItem.MimeContent)
+	}
+	for _, contact := range items.Contact {
+		mimeContents = append(mimeContents, contact.MimeContent)
+	}
+	for _, task := range items.Task {
+		mimeContents = append(mimeContents, task.MimeContent)
+	}
+	if len(mimeContents) != 1 {
+		return nil, errors.Errorf("expected 1 item, got %d", len(mimeContents))
+	}
+	if mimeContents[0] == nil {
+		return nil, errors.New("item has no mime content")
+	}
+	return mimeContents[0], nil
+}
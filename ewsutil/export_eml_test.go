@@ -0,0 +1,66 @@
+package ewsutil
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const eml = "From: Jane Doe <jane@contoso.com>\r\nTo: john@contoso.com\r\nSubject: Quarterly report\r\nMessage-ID: <42@contoso.com>\r\n\r\nSee attached.\r\n"
+
+func getMimeItemResponse() string {
+	return soapEnvelope(`<m:GetItemResponse><m:ResponseMessages>
+  <m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message>
+      <t:MimeContent CharacterSet="UTF-8">` + base64.StdEncoding.EncodeToString([]byte(eml)) + `</t:MimeContent>
+      <t:ItemId Id="AAMkMessage" ChangeKey="CK1" />
+    </t:Message></m:Items>
+  </m:GetItemResponseMessage>
+</m:ResponseMessages></m:GetItemResponse>`)
+}
+
+func Test_ExportEML(t *testing.T) {
+	c := &stubClient{responses: []string{getMimeItemResponse()}}
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportEML(c, ews.ItemId{Id: "AAMkMessage"}, &buf))
+	assert.Equal(t, eml, buf.String())
+	assert.Contains(t, c.requests[0], `<IncludeMimeContent xmlns="http://schemas.microsoft.com/exchange/services/2006/types">true</IncludeMimeContent>`)
+}
+
+func Test_GetMailMessage(t *testing.T) {
+	c := &stubClient{responses: []string{getMimeItemResponse()}}
+
+	m, err := GetMailMessage(c, ews.ItemId{Id: "AAMkMessage"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Quarterly report", m.Header.Get("Subject"))
+	from, err := m.Header.AddressList("From")
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", from[0].Name)
+
+	body, err := io.ReadAll(m.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "See attached.\r\n", string(body))
+}
+
+func Test_ExportEML_calendarItem(t *testing.T) {
+	const ics = "Subject: Planning Meeting\r\nContent-Type: text/calendar; charset=utf-8\r\n\r\nBEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+	c := &stubClient{responses: []string{soapEnvelope(`<m:GetItemResponse><m:ResponseMessages>
+  <m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:CalendarItem>
+      <t:MimeContent CharacterSet="UTF-8">` + base64.StdEncoding.EncodeToString([]byte(ics)) + `</t:MimeContent>
+      <t:ItemId Id="AAMkEvent" ChangeKey="DwAAAA" />
+    </t:CalendarItem></m:Items>
+  </m:GetItemResponseMessage>
+</m:ResponseMessages></m:GetItemResponse>`)}}
+
+	var buf bytes.Buffer
+	require.NoError(t, ExportEML(c, ews.ItemId{Id: "AAMkEvent"}, &buf))
+	assert.Equal(t, ics, buf.String())
+}
@@ -14,7 +14,7 @@ func FindPeople(c ews.Client, q string) ([]ews.Persona, error) {
 		Offset:             0,
 		BasePoint:          ews.BasePointBeginning,
 	}, ParentFolderId: ews.ParentFolderId{
-		DistinguishedFolderId: ews.DistinguishedFolderId{Id: "directory"}},
+		DistinguishedFolderId: &ews.DistinguishedFolderId{Id: "directory"}},
 		PersonaShape: &ews.PersonaShape{BaseShape: ews.BaseShapeIdOnly,
 			AdditionalProperties: ews.AdditionalProperties{
 				FieldURI: []ews.FieldURI{
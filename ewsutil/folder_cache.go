@@ -0,0 +1,81 @@
+package ewsutil
+
+import (
+	"sync"
+
+	"github.com/hoshii-ai/ews"
+)
+
+// FolderCache keeps the folder tree of each mailbox to resolve folder paths without
+// fetching the hierarchy every time. Folders created through Resolve are added to the
+// cached tree, call Invalidate after the hierarchy changed by other means.
+type FolderCache struct {
+	c ews.Client
+
+	mu    sync.Mutex
+	trees map[string]*FolderTree
+}
+
+func NewFolderCache(c ews.Client) *FolderCache {
+	return &FolderCache{
+		c:     c,
+		trees: make(map[string]*FolderTree),
+	}
+}
+
+// Tree returns a copy of the cached folder tree of mailbox, fetching it on first use.
+// The copy is not updated by later calls to Resolve, so it can be walked without locking.
+func (fc *FolderCache) Tree(mailbox string) (*FolderTree, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	t, err := fc.tree(mailbox)
+	if err != nil {
+		return nil, err
+	}
+	return t.clone(), nil
+}
+
+func (fc *FolderCache) tree(mailbox string) (*FolderTree, error) {
+	if t, ok := fc.trees[mailbox]; ok {
+		return t, nil
+	}
+
+	t, err := GetFolderTree(fc.c, mailbox)
+	if err != nil {
+		return nil, err
+	}
+	fc.trees[mailbox] = t
+	return t, nil
+}
+
+// Resolve returns the FolderId of the folder at path, see ResolveFolderPath
+func (fc *FolderCache) Resolve(mailbox, path string, create bool) (*ews.FolderId, error) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	t, err := fc.tree(mailbox)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := t.resolve(fc.c, path, create)
+	if err != nil {
+		return nil, err
+	}
+	return node.FolderId, nil
+}
+
+// Invalidate drops the cached tree of mailbox, it is fetched again on next use
+func (fc *FolderCache) Invalidate(mailbox string) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	delete(fc.trees, mailbox)
+}
+
+// InvalidateAll drops the cached trees of all mailboxes
+func (fc *FolderCache) InvalidateAll() {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.trees = make(map[string]*FolderTree)
+}
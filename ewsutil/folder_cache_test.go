@@ -0,0 +1,113 @@
+package ewsutil
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubClient replays canned SOAP responses and records the requests
+type stubClient struct {
+	responses []string
+	requests  []string
+}
+
+func (s *stubClient) SendAndReceive(body []byte) ([]byte, error) {
+	s.requests = append(s.requests, string(body))
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+	return []byte(resp), nil
+}
+
+func (s *stubClient) GetEWSAddr() string  { return "https://outlook.contoso.com/EWS/Exchange.asmx" }
+func (s *stubClient) GetUsername() string { return "jane@contoso.com" }
+
+func soapEnvelope(body string) string {
+	return `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <s:Body>` + body + `</s:Body>
+</s:Envelope>`
+}
+
+func createFolderResponse(id string) string {
+	return soapEnvelope(`<m:CreateFolderResponse><m:ResponseMessages>
+  <m:CreateFolderResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Folders><t:Folder><t:FolderId Id="` + id + `" ChangeKey="CK1" /></t:Folder></m:Folders>
+  </m:CreateFolderResponseMessage>
+</m:ResponseMessages></m:CreateFolderResponse>`)
+}
+
+func newTestFolderCache(c ews.Client) *FolderCache {
+	fc := NewFolderCache(c)
+	root := ews.BaseFolder{FolderId: &ews.FolderId{Id: "root"}}
+	fc.trees[""] = newFolderTree("", root, []ews.BaseFolder{
+		testFolder("inbox", "root", "Inbox"),
+	}, map[string]string{ews.DistinguishedFolderIdInbox: "inbox"})
+	return fc
+}
+
+func Test_FolderCache_Tree_returnsCopy(t *testing.T) {
+	fc := newTestFolderCache(&stubClient{responses: []string{createFolderResponse("clients")}})
+
+	tree, err := fc.Tree("")
+	require.NoError(t, err)
+
+	folderId, err := fc.Resolve("", "Inbox/Clients", true)
+	require.NoError(t, err)
+	assert.Equal(t, "clients", folderId.Id)
+
+	_, ok := tree.Find("Inbox/Clients")
+	assert.False(t, ok)
+	inbox, ok := tree.Find("Inbox")
+	require.True(t, ok)
+	assert.Equal(t, tree.Root, inbox.Parent)
+
+	tree, err = fc.Tree("")
+	require.NoError(t, err)
+	node, ok := tree.Find("Inbox/Clients")
+	require.True(t, ok)
+	assert.Equal(t, "Inbox/Clients", node.Path)
+	assert.Same(t, node, node.Parent.Children[0])
+}
+
+// run with -race, Resolve adds folders to the cached tree while the copies are walked
+func Test_FolderCache_concurrentUse(t *testing.T) {
+	const workers = 8
+	c := &stubClient{}
+	for i := 0; i < workers; i++ {
+		c.responses = append(c.responses, createFolderResponse(fmt.Sprintf("folder%d", i)))
+	}
+	fc := newTestFolderCache(c)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_, err := fc.Resolve("", fmt.Sprintf("Inbox/Folder %d", i), true)
+			assert.NoError(t, err)
+		}(i)
+		go func() {
+			defer wg.Done()
+			tree, err := fc.Tree("")
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.NoError(t, tree.Walk(func(node *FolderNode) error {
+				_ = node.Path
+				return nil
+			}))
+		}()
+	}
+	wg.Wait()
+
+	tree, err := fc.Tree("")
+	require.NoError(t, err)
+	inbox, ok := tree.Find("Inbox")
+	require.True(t, ok)
+	assert.Len(t, inbox.Children, workers)
+}
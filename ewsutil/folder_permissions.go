@@ -0,0 +1,254 @@
+package ewsutil
+
+import (
+	"strings"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// FolderPermissions are the permissions of a folder, calendar folders carry
+// CalendarPermissions and every other folder Permissions
+type FolderPermissions struct {
+	FolderId            ews.FolderId
+	IsCalendar          bool
+	Permissions         []ews.Permission
+	CalendarPermissions []ews.CalendarPermission
+
+	folders ews.Folders // the folder as returned, to send back the same folder type
+}
+
+// GetFolderPermissions returns the permissions set on a folder
+func GetFolderPermissions(c ews.Client, folderId ews.TargetFolderId) (*FolderPermissions, error) {
+	getFolderResponse, err := ews.GetFolder(c, ews.NewFolderIds(folderId), ews.GetFolderRequestConfig{
+		FolderShape: &ews.FolderShape{
+			BaseShape: ews.BaseShapeIdOnly,
+			AdditionalProperties: &ews.AdditionalProperties{
+				FieldURI: []ews.FieldURI{{FieldURI: "folder:PermissionSet"}},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get folder")
+	}
+
+	messages := getFolderResponse.ResponseMessages.GetFolderResponseMessage
+	if len(messages) != 1 {
+		return nil, errors.Errorf("expected 1 folder, got %d", len(messages))
+	}
+
+	folders := messages[0].Folders
+	all := folders.All()
+	if len(all) != 1 || all[0].FolderId == nil {
+		return nil, errors.New("folder id missing")
+	}
+
+	p := &FolderPermissions{
+		FolderId: *all[0].FolderId,
+		folders:  folders,
+	}
+
+	var permissionSet *ews.PermissionSet
+	switch {
+	case len(folders.CalendarFolder) == 1:
+		p.IsCalendar = true
+		if set := folders.CalendarFolder[0].PermissionSet; set != nil && set.CalendarPermissions != nil {
+			p.CalendarPermissions = set.CalendarPermissions.CalendarPermission
+		}
+	case len(folders.Folder) == 1:
+		permissionSet = folders.Folder[0].PermissionSet
+	case len(folders.ContactsFolder) == 1:
+		permissionSet = folders.ContactsFolder[0].PermissionSet
+	case len(folders.TasksFolder) == 1:
+		permissionSet = folders.TasksFolder[0].PermissionSet
+	case len(folders.SearchFolder) == 1:
+		permissionSet = folders.SearchFolder[0].PermissionSet
+	}
+	if permissionSet != nil && permissionSet.Permissions != nil {
+		p.Permissions = permissionSet.Permissions.Permission
+	}
+
+	return p, nil
+}
+
+// GrantFolderPermission gives user the permission level on a mail, contacts or tasks folder,
+// replacing the level the user had
+func GrantFolderPermission(c ews.Client, folderId ews.TargetFolderId, user ews.UserId, level ews.PermissionLevel) error {
+	p, err := GetFolderPermissions(c, folderId)
+	if err != nil {
+		return err
+	}
+	if p.IsCalendar {
+		return errors.New("folder is a calendar, use GrantCalendarPermission")
+	}
+
+	p.Permissions = setPermission(p.Permissions, ews.Permission{UserId: user, PermissionLevel: level})
+	return updateFolderPermissions(c, p)
+}
+
+// GrantCalendarPermission gives user the permission level on a calendar folder,
+// replacing the level the user had
+func GrantCalendarPermission(c ews.Client, folderId ews.TargetFolderId, user ews.UserId, level ews.CalendarPermissionLevel) error {
+	p, err := GetFolderPermissions(c, folderId)
+	if err != nil {
+		return err
+	}
+	if !p.IsCalendar {
+		return errors.New("folder is not a calendar, use GrantFolderPermission")
+	}
+
+	p.CalendarPermissions = setCalendarPermission(p.CalendarPermissions, ews.CalendarPermission{UserId: user, CalendarPermissionLevel: level})
+	return updateFolderPermissions(c, p)
+}
+
+// RevokeFolderPermission removes the permission of user on any folder, the Default and
+// Anonymous users can not be removed and are set to the None level instead
+func RevokeFolderPermission(c ews.Client, folderId ews.TargetFolderId, user ews.UserId) error {
+	p, err := GetFolderPermissions(c, folderId)
+	if err != nil {
+		return err
+	}
+
+	if user.DistinguishedUser != nil {
+		if p.IsCalendar {
+			p.CalendarPermissions = setCalendarPermission(p.CalendarPermissions, ews.CalendarPermission{UserId: user, CalendarPermissionLevel: ews.CalendarPermissionLevelNone})
+		} else {
+			p.Permissions = setPermission(p.Permissions, ews.Permission{UserId: user, PermissionLevel: ews.PermissionLevelNone})
+		}
+		return updateFolderPermissions(c, p)
+	}
+
+	var revoked bool
+	if p.IsCalendar {
+		for i, permission := range p.CalendarPermissions {
+			if sameUser(permission.UserId, user) {
+				p.CalendarPermissions = append(p.CalendarPermissions[:i], p.CalendarPermissions[i+1:]...)
+				revoked = true
+				break
+			}
+		}
+	} else {
+		for i, permission := range p.Permissions {
+			if sameUser(permission.UserId, user) {
+				p.Permissions = append(p.Permissions[:i], p.Permissions[i+1:]...)
+				revoked = true
+				break
+			}
+		}
+	}
+	if !revoked {
+		return nil
+	}
+
+	return updateFolderPermissions(c, p)
+}
+
+func setPermission(permissions []ews.Permission, permission ews.Permission) []ews.Permission {
+	for i := range permissions {
+		if sameUser(permissions[i].UserId, permission.UserId) {
+			permissions[i] = permission
+			return permissions
+		}
+	}
+	return append(permissions, permission)
+}
+
+func setCalendarPermission(permissions []ews.CalendarPermission, permission ews.CalendarPermission) []ews.CalendarPermission {
+	for i := range permissions {
+		if sameUser(permissions[i].UserId, permission.UserId) {
+			permissions[i] = permission
+			return permissions
+		}
+	}
+	return append(permissions, permission)
+}
+
+func sameUser(a, b ews.UserId) bool {
+	switch {
+	case a.DistinguishedUser != nil || b.DistinguishedUser != nil:
+		return a.DistinguishedUser != nil && b.DistinguishedUser != nil && *a.DistinguishedUser == *b.DistinguishedUser
+	case a.PrimarySmtpAddress != nil && b.PrimarySmtpAddress != nil:
+		return strings.EqualFold(*a.PrimarySmtpAddress, *b.PrimarySmtpAddress)
+	case a.SID != nil && b.SID != nil:
+		return *a.SID == *b.SID
+	}
+	return false
+}
+
+// writablePermissions strips the individual rights of the entries using a predefined level,
+// the server returns them expanded but rejects them alongside a level other than Custom
+func writablePermissions(permissions []ews.Permission) []ews.Permission {
+	writable := make([]ews.Permission, 0, len(permissions))
+	for _, permission := range permissions {
+		if permission.PermissionLevel != ews.PermissionLevelCustom {
+			permission = ews.Permission{UserId: permission.UserId, PermissionLevel: permission.PermissionLevel}
+		}
+		permission.UserId = writableUserId(permission.UserId)
+		writable = append(writable, permission)
+	}
+	return writable
+}
+
+func writableCalendarPermissions(permissions []ews.CalendarPermission) []ews.CalendarPermission {
+	writable := make([]ews.CalendarPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		if permission.CalendarPermissionLevel != ews.CalendarPermissionLevelCustom {
+			permission = ews.CalendarPermission{UserId: permission.UserId, CalendarPermissionLevel: permission.CalendarPermissionLevel}
+		}
+		permission.UserId = writableUserId(permission.UserId)
+		writable = append(writable, permission)
+	}
+	return writable
+}
+
+// writableUserId keeps a single way of identifying the user, as the server expects on write
+func writableUserId(userId ews.UserId) ews.UserId {
+	switch {
+	case userId.DistinguishedUser != nil:
+		return ews.UserId{DistinguishedUser: userId.DistinguishedUser}
+	case userId.PrimarySmtpAddress != nil:
+		return ews.UserId{PrimarySmtpAddress: userId.PrimarySmtpAddress}
+	}
+	return ews.UserId{SID: userId.SID}
+}
+
+func updateFolderPermissions(c ews.Client, p *FolderPermissions) error {
+	field := ews.SetFolderField{
+		FieldURI: &ews.FieldURI{FieldURI: "folder:PermissionSet"},
+	}
+
+	permissionSet := &ews.PermissionSet{
+		Permissions: &ews.Permissions{Permission: writablePermissions(p.Permissions)},
+	}
+	switch {
+	case p.IsCalendar:
+		field.CalendarFolder = &ews.CalendarFolder{
+			PermissionSet: &ews.CalendarPermissionSet{
+				CalendarPermissions: &ews.CalendarPermissions{CalendarPermission: writableCalendarPermissions(p.CalendarPermissions)},
+			},
+		}
+	case len(p.folders.ContactsFolder) == 1:
+		field.ContactsFolder = &ews.ContactsFolder{PermissionSet: permissionSet}
+	case len(p.folders.TasksFolder) == 1:
+		field.TasksFolder = &ews.TasksFolder{Folder: ews.Folder{PermissionSet: permissionSet}}
+	case len(p.folders.SearchFolder) == 1:
+		field.SearchFolder = &ews.SearchFolder{Folder: ews.Folder{PermissionSet: permissionSet}}
+	default:
+		field.Folder = &ews.Folder{PermissionSet: permissionSet}
+	}
+
+	_, err := ews.UpdateFolder(c, &ews.UpdateFolderRequest{
+		FolderChanges: ews.FolderChanges{
+			FolderChange: []ews.FolderChange{{
+				TargetFolderId: ews.NewTargetFolderId(ews.FolderId{Id: p.FolderId.Id}),
+				Updates: ews.FolderUpdates{
+					SetFolderField: []ews.SetFolderField{field},
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to update folder permissions")
+	}
+	return nil
+}
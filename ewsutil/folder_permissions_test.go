@@ -0,0 +1,50 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_writablePermissions(t *testing.T) {
+	permissions := []ews.Permission{
+		{
+			UserId:          ews.UserId{DistinguishedUser: utils.Ptr(ews.DistinguishedUserDefault)},
+			IsFolderVisible: utils.Ptr(false),
+			PermissionLevel: ews.PermissionLevelNone,
+		},
+		{
+			UserId:          ews.UserId{SID: utils.Ptr("S-1-5-21"), PrimarySmtpAddress: utils.Ptr("jane@contoso.com"), DisplayName: utils.Ptr("Jane")},
+			IsFolderVisible: utils.Ptr(true),
+			PermissionLevel: ews.PermissionLevelCustom,
+		},
+	}
+
+	permissions = setPermission(permissions, ews.Permission{
+		UserId:          ews.UserId{PrimarySmtpAddress: utils.Ptr("JANE@contoso.com")},
+		PermissionLevel: ews.PermissionLevelReviewer,
+	})
+	permissions = setPermission(permissions, ews.Permission{
+		UserId:          ews.UserId{PrimarySmtpAddress: utils.Ptr("john@contoso.com")},
+		CanCreateItems:  utils.Ptr(true),
+		PermissionLevel: ews.PermissionLevelCustom,
+	})
+
+	assert.Equal(t, []ews.Permission{
+		{
+			UserId:          ews.UserId{DistinguishedUser: utils.Ptr(ews.DistinguishedUserDefault)},
+			PermissionLevel: ews.PermissionLevelNone,
+		},
+		{
+			UserId:          ews.UserId{PrimarySmtpAddress: utils.Ptr("JANE@contoso.com")},
+			PermissionLevel: ews.PermissionLevelReviewer,
+		},
+		{
+			UserId:          ews.UserId{PrimarySmtpAddress: utils.Ptr("john@contoso.com")},
+			CanCreateItems:  utils.Ptr(true),
+			PermissionLevel: ews.PermissionLevelCustom,
+		},
+	}, writablePermissions(permissions))
+}
@@ -0,0 +1,401 @@
+package ewsutil
+
+import (
+	"strings"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// FolderPathSeparator separates the display names of a folder path, ex: Inbox/Clients/Acme
+const FolderPathSeparator = "/"
+
+// folderTreePageSize is the number of folders requested per FindFolder page
+const folderTreePageSize = 500
+
+// wellKnownFolders are the distinguished folders that may start a folder path,
+// their display names are localized so they are matched by distinguished id too
+var wellKnownFolders = []string{
+	ews.DistinguishedFolderIdInbox,
+	ews.DistinguishedFolderIdDrafts,
+	ews.DistinguishedFolderIdOutbox,
+	ews.DistinguishedFolderIdSentItems,
+	ews.DistinguishedFolderIdDeletedItems,
+	ews.DistinguishedFolderIdJunkEmail,
+	ews.DistinguishedFolderIdCalendar,
+	ews.DistinguishedFolderIdContacts,
+	ews.DistinguishedFolderIdTasks,
+}
+
+// SkipFolder is returned by a WalkFolders visitor to skip the sub folders of the visited folder
+var SkipFolder = errors.New("skip this folder")
+
+// FolderNode is a folder of the hierarchy with its display name path from the root
+type FolderNode struct {
+	ews.BaseFolder
+	Path     string
+	Parent   *FolderNode
+	Children []*FolderNode
+}
+
+// Name returns the display name of the folder
+func (n *FolderNode) Name() string {
+	if n.DisplayName == nil {
+		return ""
+	}
+	return *n.DisplayName
+}
+
+// FolderTree is the folder hierarchy of a mailbox below msgfolderroot, or archivemsgfolderroot
+type FolderTree struct {
+	Mailbox string
+	Root    *FolderNode
+
+	byId          map[string]*FolderNode
+	distinguished map[string]*FolderNode
+}
+
+// wellKnownArchiveFolders are the distinguished folders that may start a path in the online archive
+var wellKnownArchiveFolders = []string{
+	ews.DistinguishedFolderIdArchiveInbox,
+	ews.DistinguishedFolderIdArchiveDeletedItems,
+}
+
+// GetFolderTree fetches the whole folder hierarchy of mailbox, the caller's own mailbox when empty
+func GetFolderTree(c ews.Client, mailbox string) (*FolderTree, error) {
+	return getFolderTree(c, mailbox, ews.DistinguishedFolderIdMsgFolderRoot, wellKnownFolders)
+}
+
+// GetArchiveFolderTree fetches the folder hierarchy of the online archive of mailbox,
+// paths may start with archiveinbox or archivedeleteditems
+func GetArchiveFolderTree(c ews.Client, mailbox string) (*FolderTree, error) {
+	return getFolderTree(c, mailbox, ews.DistinguishedFolderIdArchiveMsgFolderRoot, wellKnownArchiveFolders)
+}
+
+func getFolderTree(c ews.Client, mailbox, rootId string, wellKnown []string) (*FolderTree, error) {
+	email := mailboxEmail(mailbox)
+
+	targets := []ews.TargetFolderId{ews.NewDistinguishedTargetFolderId(rootId, email)}
+	for _, id := range wellKnown {
+		targets = append(targets, ews.NewDistinguishedTargetFolderId(id, email))
+	}
+
+	getFolderResponse, err := ews.GetFolder(c, ews.NewFolderIds(targets...), ews.GetFolderRequestConfig{
+		FolderShape: &ews.FolderShape{BaseShape: ews.BaseShapeIdOnly},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get well-known folders")
+	}
+
+	messages := getFolderResponse.ResponseMessages.GetFolderResponseMessage
+	if len(messages) != len(targets) {
+		return nil, errors.Errorf("expected %d folders, got %d", len(targets), len(messages))
+	}
+
+	var root ews.BaseFolder
+	distinguished := make(map[string]string)
+	for i, message := range messages {
+		folders := message.Folders.All()
+		if len(folders) != 1 || folders[0].FolderId == nil {
+			return nil, errors.New("folder id missing for " + targets[i].DistinguishedFolderId.Id)
+		}
+		if i == 0 {
+			root = folders[0]
+			continue
+		}
+		distinguished[targets[i].DistinguishedFolderId.Id] = folders[0].FolderId.Id
+	}
+
+	folders, err := findAllFolders(c, ews.NewFolderIds(targets[0]), ews.FolderTraversalDeep)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFolderTree(mailbox, root, folders, distinguished), nil
+}
+
+// findAllFolders pages through FindFolder until the last folder is returned
+func findAllFolders(c ews.Client, parentFolderIds ews.FolderIds, traversal ews.FolderTraversal) ([]ews.BaseFolder, error) {
+	var folders []ews.BaseFolder
+	offset := 0
+	for {
+		findFolderResponse, err := ews.FindFolder(c, parentFolderIds, ews.FindFolderRequestConfig{
+			Traversal: &traversal,
+			IndexedPageFolderView: &ews.IndexedPageItemView{
+				MaxEntriesReturned: folderTreePageSize,
+				Offset:             offset,
+				BasePoint:          ews.BasePointBeginning,
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find folders")
+		}
+
+		messages := findFolderResponse.ResponseMessages.FindFolderResponseMessage
+		if len(messages) != 1 {
+			return nil, errors.Errorf("expected 1 response message, got %d", len(messages))
+		}
+
+		rootFolder := messages[0].RootFolder
+		page := rootFolder.Folders.All()
+		folders = append(folders, page...)
+		if rootFolder.IncludesLastItemInRange || len(page) == 0 {
+			return folders, nil
+		}
+		offset = rootFolder.IndexedPagingOffset
+	}
+}
+
+func newFolderTree(mailbox string, root ews.BaseFolder, folders []ews.BaseFolder, distinguished map[string]string) *FolderTree {
+	t := &FolderTree{
+		Mailbox:       mailbox,
+		Root:          &FolderNode{BaseFolder: root},
+		byId:          make(map[string]*FolderNode),
+		distinguished: make(map[string]*FolderNode),
+	}
+	t.byId[root.FolderId.Id] = t.Root
+
+	nodes := make([]*FolderNode, 0, len(folders))
+	for _, folder := range folders {
+		if folder.FolderId == nil {
+			continue
+		}
+		node := &FolderNode{BaseFolder: folder}
+		t.byId[folder.FolderId.Id] = node
+		nodes = append(nodes, node)
+	}
+
+	// link once all nodes are known, the server does not list parents before their children
+	for _, node := range nodes {
+		if node.ParentFolderId == nil {
+			continue
+		}
+		if parent, ok := t.byId[node.ParentFolderId.Id]; ok {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	setFolderPaths(t.Root)
+
+	for name, id := range distinguished {
+		if node, ok := t.byId[id]; ok {
+			t.distinguished[name] = node
+		}
+	}
+
+	return t
+}
+
+func setFolderPaths(node *FolderNode) {
+	for _, child := range node.Children {
+		child.Path = joinFolderPath(node.Path, child.Name())
+		setFolderPaths(child)
+	}
+}
+
+func joinFolderPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + FolderPathSeparator + name
+}
+
+func splitFolderPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(path, FolderPathSeparator) {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// Find returns the folder at path, matching display names case-insensitively.
+// The first segment may be a distinguished folder id, ex: inbox/Clients/Acme
+// matches a localized Posteingang/Clients/Acme. An empty path returns the root.
+func (t *FolderTree) Find(path string) (*FolderNode, bool) {
+	node, rest := t.lookup(splitFolderPath(path))
+	return node, len(rest) == 0
+}
+
+// ById returns the folder with the given Exchange id
+func (t *FolderTree) ById(id string) (*FolderNode, bool) {
+	node, ok := t.byId[id]
+	return node, ok
+}
+
+// lookup returns the deepest existing folder along segments and the segments left unresolved
+func (t *FolderTree) lookup(segments []string) (*FolderNode, []string) {
+	node := t.Root
+	if len(segments) > 0 {
+		if distinguished, ok := t.distinguished[strings.ToLower(segments[0])]; ok {
+			node = distinguished
+			segments = segments[1:]
+		}
+	}
+
+	for len(segments) > 0 {
+		child := node.child(segments[0])
+		if child == nil {
+			break
+		}
+		node = child
+		segments = segments[1:]
+	}
+
+	return node, segments
+}
+
+func (n *FolderNode) child(name string) *FolderNode {
+	for _, child := range n.Children {
+		if strings.EqualFold(child.Name(), name) {
+			return child
+		}
+	}
+	return nil
+}
+
+// Walk visits the folders depth first, starting with the root,
+// a visitor returning SkipFolder skips the sub folders of the visited folder
+func (t *FolderTree) Walk(visit func(node *FolderNode) error) error {
+	err := walkFolder(t.Root, visit)
+	if err == SkipFolder {
+		return nil
+	}
+	return err
+}
+
+func walkFolder(node *FolderNode, visit func(node *FolderNode) error) error {
+	if err := visit(node); err != nil {
+		return err
+	}
+	for _, child := range node.Children {
+		if err := walkFolder(child, visit); err != nil && err != SkipFolder {
+			return err
+		}
+	}
+	return nil
+}
+
+// add links a created folder below parent
+func (t *FolderTree) add(parent *FolderNode, folder ews.BaseFolder) *FolderNode {
+	node := &FolderNode{
+		BaseFolder: folder,
+		Path:       joinFolderPath(parent.Path, *folder.DisplayName),
+		Parent:     parent,
+	}
+	parent.Children = append(parent.Children, node)
+	t.byId[folder.FolderId.Id] = node
+	return node
+}
+
+// clone returns a copy of the tree that shares no node with t
+func (t *FolderTree) clone() *FolderTree {
+	copies := make(map[*FolderNode]*FolderNode, len(t.byId))
+	clone := &FolderTree{
+		Mailbox:       t.Mailbox,
+		byId:          make(map[string]*FolderNode, len(t.byId)),
+		distinguished: make(map[string]*FolderNode, len(t.distinguished)),
+	}
+	for id, node := range t.byId {
+		copied := *node
+		copies[node] = &copied
+		clone.byId[id] = &copied
+	}
+
+	// the copies still point to the nodes of t until relinked
+	for _, node := range copies {
+		node.Parent = copies[node.Parent]
+		children := make([]*FolderNode, len(node.Children))
+		for i, child := range node.Children {
+			children[i] = copies[child]
+		}
+		node.Children = children
+	}
+
+	clone.Root = copies[t.Root]
+	for name, node := range t.distinguished {
+		clone.distinguished[name] = copies[node]
+	}
+	return clone
+}
+
+// resolve returns the folder at path, creating the missing segments when create is set
+func (t *FolderTree) resolve(c ews.Client, path string, create bool) (*FolderNode, error) {
+	node, missing := t.lookup(splitFolderPath(path))
+	if len(missing) == 0 {
+		return node, nil
+	}
+	if !create {
+		return nil, errors.New("folder not found: " + path)
+	}
+
+	for _, name := range missing {
+		folderClass := ews.FolderClassNote
+		if node.FolderClass != nil && node != t.Root {
+			folderClass = *node.FolderClass
+		}
+
+		createFolderResponse, err := ews.CreateFolder(c, ews.NewTargetFolderId(*node.FolderId), ews.Folders{
+			Folder: []ews.Folder{{
+				BaseFolder: ews.BaseFolder{
+					FolderClass: utils.Ptr(folderClass),
+					DisplayName: utils.Ptr(name),
+				},
+			}},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create folder "+joinFolderPath(node.Path, name))
+		}
+
+		messages := createFolderResponse.ResponseMessages.CreateFolderResponseMessage
+		if len(messages) != 1 {
+			return nil, errors.Errorf("expected 1 created folder, got %d", len(messages))
+		}
+		created := messages[0].Folders.All()
+		if len(created) != 1 || created[0].FolderId == nil {
+			return nil, errors.New("created folder has no id")
+		}
+
+		folder := created[0]
+		folder.DisplayName = utils.Ptr(name)
+		folder.FolderClass = utils.Ptr(folderClass)
+		folder.ParentFolderId = node.FolderId
+		node = t.add(node, folder)
+	}
+
+	return node, nil
+}
+
+// ResolveFolderPath returns the FolderId of the folder at path in mailbox, the caller's own
+// mailbox when empty, see FolderTree.Find for the path format. Missing segments are
+// created when create is set. Use a FolderCache to resolve many paths.
+func ResolveFolderPath(c ews.Client, mailbox, path string, create bool) (*ews.FolderId, error) {
+	t, err := GetFolderTree(c, mailbox)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := t.resolve(c, path, create)
+	if err != nil {
+		return nil, err
+	}
+	return node.FolderId, nil
+}
+
+// WalkFolders fetches the folder hierarchy of mailbox and visits it, see FolderTree.Walk
+func WalkFolders(c ews.Client, mailbox string, visit func(node *FolderNode) error) error {
+	t, err := GetFolderTree(c, mailbox)
+	if err != nil {
+		return err
+	}
+	return t.Walk(visit)
+}
+
+func mailboxEmail(mailbox string) *string {
+	if mailbox == "" {
+		return nil
+	}
+	return &mailbox
+}
@@ -0,0 +1,56 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testFolder(id, parentId, name string) ews.BaseFolder {
+	return ews.BaseFolder{
+		FolderId:       &ews.FolderId{Id: id},
+		ParentFolderId: &ews.FolderId{Id: parentId},
+		DisplayName:    utils.Ptr(name),
+	}
+}
+
+func Test_FolderTree(t *testing.T) {
+	root := ews.BaseFolder{FolderId: &ews.FolderId{Id: "root"}}
+	tree := newFolderTree("", root, []ews.BaseFolder{
+		testFolder("acme", "clients", "Acme"),
+		testFolder("inbox", "root", "Posteingang"),
+		testFolder("clients", "inbox", "Clients"),
+		testFolder("archive", "root", "Archive"),
+		testFolder("old", "archive", "Old"),
+	}, map[string]string{ews.DistinguishedFolderIdInbox: "inbox"})
+
+	node, ok := tree.Find("Inbox/clients/ACME")
+	require.True(t, ok)
+	assert.Equal(t, "acme", node.FolderId.Id)
+	assert.Equal(t, "Posteingang/Clients/Acme", node.Path)
+
+	node, ok = tree.Find("/Posteingang/Clients/")
+	require.True(t, ok)
+	assert.Equal(t, "clients", node.FolderId.Id)
+
+	_, ok = tree.Find("Inbox/Clients/Globex")
+	assert.False(t, ok)
+
+	node, ok = tree.Find("")
+	require.True(t, ok)
+	assert.Equal(t, tree.Root, node)
+
+	var visited []string
+	err := tree.Walk(func(node *FolderNode) error {
+		visited = append(visited, node.Path)
+		if node.Name() == "Archive" {
+			return SkipFolder
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "Posteingang", "Posteingang/Clients", "Posteingang/Clients/Acme", "Archive"}, visited)
+}
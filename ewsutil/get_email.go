@@ -61,11 +61,11 @@ func GetMessageByInternetMessageId(c ews.Client, internetMessageId string) (*ews
 		return nil, errors.Wrap(err, "failed to find item")
 	}
 
-	if findItemResponse.ResponseMessages.FindItemResponseMessage.ResponseClass != ews.ResponseClassSuccess {
-		return nil, errors.New("failed to find item: " + findItemResponse.ResponseMessages.FindItemResponseMessage.ResponseCode)
+	if findItemResponse.ResponseMessages.FindItemResponseMessage[0].ResponseClass != ews.ResponseClassSuccess {
+		return nil, errors.New("failed to find item: " + findItemResponse.ResponseMessages.FindItemResponseMessage[0].ResponseCode)
 	}
 
-	rootFolder := findItemResponse.ResponseMessages.FindItemResponseMessage.RootFolder
+	rootFolder := findItemResponse.ResponseMessages.FindItemResponseMessage[0].RootFolder
 
 	messages := rootFolder.Items.Message
 	if len(messages) != 1 {
@@ -0,0 +1,42 @@
+package ewsutil
+
+import (
+	"strconv"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// GetMessageUniqueBody returns only the content of a message that is not part of the
+// previous messages of its conversation, ex: the reply without the quoted thread.
+// bodyType is one of ews.BodyTypeText or ews.BodyTypeHTML.
+func GetMessageUniqueBody(c ews.Client, itemId *ews.ItemId, bodyType string) (*ews.Body, error) {
+	getItemConfig := ews.GetItemRequestConfig{
+		ItemShape: &ews.ItemShape{
+			BaseShape:      ews.BaseShapeIdOnly,
+			UniqueBodyType: bodyType,
+			AdditionalProperties: &ews.AdditionalProperties{
+				FieldURI: []ews.FieldURI{
+					{
+						FieldURI: "item:UniqueBody",
+					},
+				},
+			},
+		},
+	}
+	getItemResponse, err := ews.GetItem(c, *itemId, getItemConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get item")
+	}
+
+	messages := getItemResponse.ResponseMessages.GetItemResponseMessage.Items.Message
+	if len(messages) != 1 {
+		return nil, errors.New("expected 1 message, got " + strconv.Itoa(len(messages)))
+	}
+
+	if messages[0].UniqueBody == nil {
+		return nil, errors.New("message has no unique body")
+	}
+
+	return messages[0].UniqueBody, nil
+}
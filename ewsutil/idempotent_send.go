@@ -0,0 +1,143 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// IdempotencyKeyFieldURI is the named property SendEmailIdempotent stamps messages with,
+// it is kept on the sent copy so that a retry can find out the message was already sent
+var IdempotencyKeyFieldURI = ews.ExtendedFieldURI{
+	DistinguishedPropertySetId: ews.DistinguishedPropertySetIdPublicStrings,
+	PropertyName:               "IdempotencyKey",
+	PropertyType:               ews.PropertyTypeString,
+}
+
+// SendEmailIdempotent sends m at most once per key, so it can be retried after a timeout
+// or a crash without emailing the recipients twice:
+//   - when a message stamped with key is in the sent items or the outbox, it was already
+//     sent and its id is returned
+//   - the drafts stamped with key left over by a failed attempt are deleted
+//   - m is stamped with key, saved and sent, when the send fails without a response from
+//     the server the sent items and the outbox are checked again before returning the error
+func SendEmailIdempotent(c ews.Client, m ews.Message, key string) (*ews.ItemId, error) {
+	itemId, err := sendEmailIdempotent(c, m, key, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send email")
+	}
+	return itemId, nil
+}
+
+func sendEmailIdempotent(c ews.Client, m ews.Message, key, mailbox string) (*ews.ItemId, error) {
+	if key == "" {
+		return nil, errors.New("idempotency key is empty")
+	}
+
+	itemId, err := findSentByIdempotencyKey(c, key, mailbox)
+	if err != nil {
+		return nil, err
+	}
+	if itemId != nil {
+		return itemId, nil
+	}
+
+	if err := deleteDraftsByIdempotencyKey(c, key, mailbox); err != nil {
+		return nil, err
+	}
+
+	n := len(m.ExtendedProperties)
+	m.ExtendedProperties = append(m.ExtendedProperties[:n:n], ews.ExtendedProperty{
+		ExtendedFieldURI: &IdempotencyKeyFieldURI,
+		Value:            utils.Ptr(key),
+	})
+
+	draftsFolderId := ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdDrafts, mailboxEmail(mailbox))
+	itemId, err = ews.CreateMessageItem(c, m, ews.CreateItemRequestConfig{
+		MessageDisposition: ews.MessageDispositionSaveOnly,
+		SavedItemFolderId:  &draftsFolderId,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create message item")
+	}
+
+	sendErr := sendDraft(c, itemId, mailbox)
+	if sendErr == nil {
+		return itemId, nil
+	}
+
+	var responseError *ews.ResponseError
+	if errors.As(sendErr, &responseError) {
+		return nil, sendErr
+	}
+
+	// the request failed before the server answered: the message may have been sent
+	sentItemId, err := findSentByIdempotencyKey(c, key, mailbox)
+	if err == nil && sentItemId != nil {
+		return sentItemId, nil
+	}
+	return nil, sendErr
+}
+
+// findSentByIdempotencyKey returns the id of the message stamped with key in the sent items
+// or the outbox of mailbox, nil when there is none
+func findSentByIdempotencyKey(c ews.Client, key, mailbox string) (*ews.ItemId, error) {
+	for _, folderId := range []string{ews.DistinguishedFolderIdSentItems, ews.DistinguishedFolderIdOutbox} {
+		itemIds, err := findByIdempotencyKey(c, folderId, key, mailbox)
+		if err != nil {
+			return nil, err
+		}
+		if len(itemIds) > 0 {
+			return &itemIds[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func deleteDraftsByIdempotencyKey(c ews.Client, key, mailbox string) error {
+	itemIds, err := findByIdempotencyKey(c, ews.DistinguishedFolderIdDrafts, key, mailbox)
+	if err != nil || len(itemIds) == 0 {
+		return err
+	}
+
+	itemErrors, err := DeleteItems(c, itemIds, ews.DisposalTypeHardDelete)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete orphaned drafts")
+	}
+	for _, itemError := range itemErrors {
+		if itemError != nil && !ews.IsResponseCode(itemError, "ErrorItemNotFound") {
+			return errors.Wrap(itemError, "failed to delete orphaned draft")
+		}
+	}
+	return nil
+}
+
+func findByIdempotencyKey(c ews.Client, folderId, key, mailbox string) ([]ews.ItemId, error) {
+	parentFolderId := ews.NewDistinguishedTargetFolderId(folderId, mailboxEmail(mailbox))
+	findItemResponse, err := ews.FindItemInFolders(c, ews.NewFolderIds(parentFolderId), ews.FindItemRequestConfig{
+		Traversal: utils.Ptr(ews.FindItemTraversalShallow),
+		BaseShape: utils.Ptr(ews.BaseShapeIdOnly),
+		Restriction: &ews.Restriction{
+			IsEqualTo: &ews.IsEqualTo{
+				ExtendedFieldURI:   &IdempotencyKeyFieldURI,
+				FieldURIOrConstant: ews.NewConstant(key),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to search %s", folderId)
+	}
+
+	message := findItemResponse.ResponseMessages.FindItemResponseMessage[0]
+	if err := message.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to search %s", folderId)
+	}
+
+	var itemIds []ews.ItemId
+	for _, m := range message.RootFolder.Items.Message {
+		if m.ItemId != nil {
+			itemIds = append(itemIds, *m.ItemId)
+		}
+	}
+	return itemIds, nil
+}
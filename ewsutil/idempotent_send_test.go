@@ -0,0 +1,81 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeoutClient fails the request at index failAt without answering, like a timeout
+type timeoutClient struct {
+	*stubClient
+	failAt int
+}
+
+func (s *timeoutClient) SendAndReceive(body []byte) ([]byte, error) {
+	if len(s.requests) == s.failAt {
+		s.requests = append(s.requests, string(body))
+		return nil, errors.New("context deadline exceeded")
+	}
+	return s.stubClient.SendAndReceive(body)
+}
+
+func Test_SendEmailIdempotent_alreadySent(t *testing.T) {
+	c := &stubClient{responses: []string{findItemResponse("AAMkSent")}}
+
+	itemId, err := SendEmailIdempotent(c, ews.Message{Subject: utils.Ptr("Invoice")}, "invoice-42")
+	require.NoError(t, err)
+	assert.Equal(t, "AAMkSent", itemId.Id)
+	require.Len(t, c.requests, 1)
+	assert.Contains(t, c.requests[0], `Id="sentitems"`)
+	assert.Contains(t, c.requests[0], `DistinguishedPropertySetId="PublicStrings" PropertyType="String" PropertyName="IdempotencyKey"`)
+	assert.Contains(t, c.requests[0], `Value="invoice-42"`)
+}
+
+func Test_SendEmailIdempotent_deletesOrphanedDrafts(t *testing.T) {
+	c := &stubClient{responses: []string{
+		findItemResponse(),
+		findItemResponse(),
+		findItemResponse("AAMkOrphan"),
+		soapEnvelope(`<m:DeleteItemResponse><m:ResponseMessages>
+  <m:DeleteItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:DeleteItemResponseMessage>
+</m:ResponseMessages></m:DeleteItemResponse>`),
+		soapEnvelope(createDraftResponse),
+		soapEnvelope(sendItemResponse),
+	}}
+
+	itemId, err := SendEmailIdempotent(c, ews.Message{Subject: utils.Ptr("Invoice")}, "invoice-42")
+	require.NoError(t, err)
+	assert.Equal(t, "AAMkDraft", itemId.Id)
+	assert.Contains(t, c.requests[1], `Id="outbox"`)
+	assert.Contains(t, c.requests[2], `Id="drafts"`)
+	assert.Contains(t, c.requests[3], `Id="AAMkOrphan"`)
+	assert.Contains(t, c.requests[4], `PropertyType="String" PropertyName="IdempotencyKey"></ExtendedFieldURI>
+        <Value xmlns="http://schemas.microsoft.com/exchange/services/2006/types">invoice-42</Value>`)
+}
+
+func Test_SendEmailIdempotent_timeout(t *testing.T) {
+	responses := []string{
+		findItemResponse(),
+		findItemResponse(),
+		findItemResponse(),
+		soapEnvelope(createDraftResponse),
+	}
+
+	// the message was sent despite the timeout
+	c := &timeoutClient{stubClient: &stubClient{responses: append(responses, findItemResponse("AAMkSent"))}, failAt: 4}
+	itemId, err := SendEmailIdempotent(c, ews.Message{}, "invoice-42")
+	require.NoError(t, err)
+	assert.Equal(t, "AAMkSent", itemId.Id)
+
+	// the message was not sent, the draft is kept for the next attempt to clean up
+	c = &timeoutClient{stubClient: &stubClient{responses: append(responses, findItemResponse(), findItemResponse())}, failAt: 4}
+	_, err = SendEmailIdempotent(c, ews.Message{}, "invoice-42")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "context deadline exceeded")
+	assert.Len(t, c.requests, 7)
+}
@@ -37,11 +37,11 @@ func GetInboxCategories(c ews.Client) (*ews.CategoryList, error) {
 		return nil, errors.Wrap(err, "failed to find item")
 	}
 
-	if findItemResponse.ResponseMessages.FindItemResponseMessage.ResponseClass != ews.ResponseClassSuccess {
-		return nil, errors.New("failed to find item: " + findItemResponse.ResponseMessages.FindItemResponseMessage.ResponseCode)
+	if findItemResponse.ResponseMessages.FindItemResponseMessage[0].ResponseClass != ews.ResponseClassSuccess {
+		return nil, errors.New("failed to find item: " + findItemResponse.ResponseMessages.FindItemResponseMessage[0].ResponseCode)
 	}
 
-	rootFolder := findItemResponse.ResponseMessages.FindItemResponseMessage.RootFolder
+	rootFolder := findItemResponse.ResponseMessages.FindItemResponseMessage[0].RootFolder
 
 	messages := rootFolder.Items.Message
 	if len(messages) == 0 {
@@ -58,17 +58,7 @@ func GetInboxCategories(c ews.Client) (*ews.CategoryList, error) {
 	}
 
 	getItemConfig := ews.GetItemRequestConfig{
-		ItemShape: &ews.ItemShape{
-			BaseShape: ews.BaseShapeAllProperties,
-			AdditionalProperties: &ews.AdditionalProperties{
-				ExtendedFieldURI: []ews.ExtendedFieldURI{
-					{
-						PropertyTag:  ews.PropertyTagCategories,
-						PropertyType: ews.PropertyTypeBinary,
-					},
-				},
-			},
-		},
+		ItemShape: categoryListItemShape(),
 	}
 
 	getItemResponse, err := ews.GetItem(c, *message.ItemId, getItemConfig)
@@ -86,6 +76,29 @@ func GetInboxCategories(c ews.Client) (*ews.CategoryList, error) {
 	}
 	message = messages[0]
 
+	return categoryListFromMessage(message)
+}
+
+func categoryListItemShape() *ews.ItemShape {
+	return &ews.ItemShape{
+		BaseShape: ews.BaseShapeAllProperties,
+		AdditionalProperties: &ews.AdditionalProperties{
+			ExtendedFieldURI: []ews.ExtendedFieldURI{
+				{
+					PropertyTag:  ews.PropertyTagCategories,
+					PropertyType: ews.PropertyTypeBinary,
+				},
+			},
+		},
+	}
+}
+
+// categoryListFromMessage decodes the category list held by the extended property of message
+func categoryListFromMessage(message ews.Message) (*ews.CategoryList, error) {
+	if message.ItemId == nil {
+		return nil, errors.New("message item id is nil")
+	}
+
 	extendedProperties := message.ExtendedProperties
 	if len(extendedProperties) != 1 {
 		return nil, errors.Errorf("expected 1 extended property, got %d", len(extendedProperties))
@@ -110,68 +123,53 @@ func GetInboxCategories(c ews.Client) (*ews.CategoryList, error) {
 	return categories, nil
 }
 
+// AddCategories adds categories to the master category list, skipping the existing ones.
+// The list is updated against its latest ChangeKey and the categories are added again
+// to the list when a concurrent write changed it.
 func AddCategories(c ews.Client, categories ...ews.Category) error {
 	categoryList, err := GetInboxCategories(c)
 	if err != nil {
 		return errors.Wrap(err, "failed to get inbox categories")
 	}
-	oldXmlData, err := categoryList.CategoryListToBase64()
-	if err != nil {
-		return errors.Wrap(err, "failed to convert category list to base64")
-	}
 
-	for _, category := range categories {
-		err := categoryList.AddCategory(category.Name, category.Color)
+	_, err = ModifyItem(c, categoryList.ItemId, ModifyItemConfig{ItemShape: categoryListItemShape()}, func(items ews.Items, b *ews.UpdateItemBuilder) error {
+		if len(items.Message) != 1 {
+			return errors.Errorf("expected 1 message, got %d", len(items.Message))
+		}
+		current, err := categoryListFromMessage(items.Message[0])
 		if err != nil {
-			// Duplicate category, skip
-			continue
+			return err
 		}
-	}
-	xmlData, err := categoryList.CategoryListToBase64()
-	if err != nil {
-		return errors.Wrap(err, "failed to convert category list to base64")
-	}
-	if xmlData == oldXmlData {
-		// No changes, skip
-		return nil
-	}
 
-	updateItemRequest := &ews.UpdateItemRequest{
-		MessageDisposition: ews.MessageDispositionSaveOnly,
-		ItemChanges: ews.ItemChanges{
-			ItemChange: []ews.ItemChange{
-				{
-					ItemId: categoryList.ItemId,
-					Updates: ews.Updates{
-						SetItemField: []ews.SetItemField{
-							{
-								ExtendedFieldURI: &ews.ExtendedFieldURI{
-									PropertyTag:  ews.PropertyTagCategories,
-									PropertyType: ews.PropertyTypeBinary,
-								},
-								Message: &ews.Message{
-									ExtendedProperties: []ews.ExtendedProperty{
-										{
-											Value: &xmlData,
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
+		oldXmlData, err := current.CategoryListToBase64()
+		if err != nil {
+			return errors.Wrap(err, "failed to convert category list to base64")
+		}
+		for _, category := range categories {
+			err := current.AddCategory(category.Name, category.Color)
+			if err != nil {
+				// Duplicate category, skip
+				continue
+			}
+		}
+		xmlData, err := current.CategoryListToBase64()
+		if err != nil {
+			return errors.Wrap(err, "failed to convert category list to base64")
+		}
+		if xmlData == oldXmlData {
+			// No changes, skip
+			return SkipUpdate
+		}
 
-	updateItemResponse, err := ews.UpdateItem(c, updateItemRequest)
+		b.SetExtended(ews.ExtendedFieldURI{
+			PropertyTag:  ews.PropertyTagCategories,
+			PropertyType: ews.PropertyTypeBinary,
+		}, xmlData)
+		return nil
+	})
 	if err != nil {
 		return errors.Wrap(err, "failed to update item")
 	}
 
-	if updateItemResponse.ResponseMessages.UpdateItemResponseMessage.ResponseClass != ews.ResponseClassSuccess {
-		return errors.New("failed to update item: " + updateItemResponse.ResponseMessages.UpdateItemResponseMessage.ResponseCode)
-	}
-
 	return nil
 }
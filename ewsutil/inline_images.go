@@ -0,0 +1,124 @@
+package ewsutil
+
+import (
+	"encoding/base64"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// InlineImage is an image shown in an HTML body rather than listed as an attachment
+type InlineImage struct {
+	// Name is the reference to the image in the HTML, ex: "logo.png" in <img src="logo.png">
+	Name string
+	// ContentType is detected from the name, then the content, when empty
+	ContentType string
+	Content     []byte
+}
+
+var (
+	// src and background attributes, and CSS url() values
+	imageReferenceRegexp = regexp.MustCompile(`(?i)(\b(?:src|background)\s*=\s*["']|url\(\s*["']?)([^"')]+)`)
+	contentIdRegexp      = regexp.MustCompile(`(?i)cid:([^"'\s)>]+)`)
+	// the characters of a file name that are not kept in a Content-ID
+	contentIdUnsafeRegexp = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// LoadInlineImage reads an image file, path is kept as the name the HTML references it by
+func LoadInlineImage(path string) (InlineImage, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return InlineImage{}, errors.Wrap(err, "failed to read image")
+	}
+	return InlineImage{Name: path, Content: content}, nil
+}
+
+// EmbedImages rewrites the references to images in html to cid: URLs and returns the inline
+// attachments holding the images. It fails when an image is not referenced by html, or when
+// a cid: reference of html does not resolve to one of the images.
+func EmbedImages(html string, images ...InlineImage) (string, []ews.FileAttachment, error) {
+	contentIds := make(map[string]string, len(images))
+	attachments := make([]ews.FileAttachment, 0, len(images))
+	for _, image := range images {
+		if _, ok := contentIds[image.Name]; ok {
+			return "", nil, errors.Errorf("duplicate image %s", image.Name)
+		}
+
+		contentType := image.ContentType
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(image.Name))
+		}
+		if contentType == "" {
+			contentType = http.DetectContentType(image.Content)
+		}
+		if !strings.HasPrefix(contentType, "image/") {
+			return "", nil, errors.Errorf("%s is not an image: %s", image.Name, contentType)
+		}
+
+		name := filepath.Base(image.Name)
+		contentId := contentIdName(name) + "@" + uuid.NewString()
+		contentIds[image.Name] = contentId
+		attachments = append(attachments, ews.FileAttachment{
+			Name:        name,
+			ContentType: contentType,
+			ContentId:   contentId,
+			IsInline:    utils.Ptr(true),
+			Content:     base64.StdEncoding.EncodeToString(image.Content),
+		})
+	}
+
+	referenced := make(map[string]bool, len(images))
+	html = imageReferenceRegexp.ReplaceAllStringFunc(html, func(reference string) string {
+		m := imageReferenceRegexp.FindStringSubmatch(reference)
+		contentId, ok := contentIds[m[2]]
+		if !ok {
+			return reference
+		}
+		referenced[m[2]] = true
+		return m[1] + "cid:" + contentId
+	})
+	for _, image := range images {
+		if !referenced[image.Name] {
+			return "", nil, errors.Errorf("image %s is not referenced by the html body", image.Name)
+		}
+	}
+
+	if err := ValidateContentIds(html, attachments); err != nil {
+		return "", nil, err
+	}
+	return html, attachments, nil
+}
+
+// contentIdName returns the characters of name that are safe in a Content-ID and in a cid: URL
+func contentIdName(name string) string {
+	name = contentIdUnsafeRegexp.ReplaceAllString(name, "")
+	if name == "" {
+		return "image"
+	}
+	return name
+}
+
+// ValidateContentIds checks that every cid: reference of html resolves to the ContentId of an attachment
+func ValidateContentIds(html string, attachments []ews.FileAttachment) error {
+	contentIds := make(map[string]bool, len(attachments))
+	for _, attachment := range attachments {
+		if attachment.ContentId != "" {
+			contentIds[attachment.ContentId] = true
+		}
+	}
+
+	for _, m := range contentIdRegexp.FindAllStringSubmatch(html, -1) {
+		if !contentIds[m[1]] {
+			return errors.Errorf("cid:%s does not match any attachment", m[1])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package ewsutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func Test_EmbedImages(t *testing.T) {
+	html, attachments, err := EmbedImages(
+		`<img src="images/logo.png"><div style="background: url('chart')"></div><img src="https://contoso.com/x.png">`,
+		InlineImage{Name: "images/logo.png", Content: pngHeader},
+		InlineImage{Name: "chart", Content: pngHeader},
+	)
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+
+	assert.Equal(t, "logo.png", attachments[0].Name)
+	assert.Equal(t, "image/png", attachments[0].ContentType)
+	assert.Equal(t, "image/png", attachments[1].ContentType)
+	assert.True(t, *attachments[0].IsInline)
+	assert.True(t, strings.HasPrefix(attachments[0].ContentId, "logo.png@"))
+
+	assert.Equal(t, `<img src="cid:`+attachments[0].ContentId+`"><div style="background: url('cid:`+attachments[1].ContentId+`')"></div><img src="https://contoso.com/x.png">`, html)
+}
+
+func Test_EmbedImages_sanitizesContentId(t *testing.T) {
+	html, attachments, err := EmbedImages(`<img src="my logo.png"><img src="été.png">`,
+		InlineImage{Name: "my logo.png", Content: pngHeader},
+		InlineImage{Name: "été.png", Content: pngHeader},
+	)
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+
+	assert.Equal(t, "my logo.png", attachments[0].Name)
+	assert.True(t, strings.HasPrefix(attachments[0].ContentId, "mylogo.png@"))
+	assert.True(t, strings.HasPrefix(attachments[1].ContentId, "t.png@"))
+	assert.Equal(t, `<img src="cid:`+attachments[0].ContentId+`"><img src="cid:`+attachments[1].ContentId+`">`, html)
+	assert.Equal(t, "image", contentIdName("ロゴ"))
+}
+
+func Test_EmbedImages_errors(t *testing.T) {
+	_, _, err := EmbedImages(`<p>no image</p>`, InlineImage{Name: "logo.png", Content: pngHeader})
+	assert.EqualError(t, err, "image logo.png is not referenced by the html body")
+
+	_, _, err = EmbedImages(`<img src="logo.png"><img src="cid:missing@contoso">`, InlineImage{Name: "logo.png", Content: pngHeader})
+	assert.EqualError(t, err, "cid:missing@contoso does not match any attachment")
+
+	_, _, err = EmbedImages(`<img src="notes.txt">`, InlineImage{Name: "notes.txt", Content: []byte("hello")})
+	assert.EqualError(t, err, "notes.txt is not an image: text/plain; charset=utf-8")
+}
+
+func Test_MessageBuilder_validatesContentIds(t *testing.T) {
+	_, err := NewMessageBuilder().
+		HTMLBody(`<img src="cid:logo@contoso">`).
+		Attach(ews.FileAttachment{Name: "logo.png", ContentId: "other@contoso"}).
+		Build()
+	assert.EqualError(t, err, "cid:logo@contoso does not match any attachment")
+}
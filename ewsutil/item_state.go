@@ -0,0 +1,117 @@
+package ewsutil
+
+import (
+	"time"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// SetReadState marks the messages as read or unread, without sending the read receipts
+// requested by their senders when suppressReadReceipts is set
+func SetReadState(c ews.Client, itemIds []ews.ItemId, isRead, suppressReadReceipts bool) error {
+	req := newSetMessageFieldRequest(itemIds, "message:IsRead", ews.Message{IsRead: utils.Ptr(isRead)})
+	if isRead && suppressReadReceipts {
+		req.SuppressReadReceipts = utils.Ptr(true)
+	}
+	return updateItems(c, req, "read state")
+}
+
+// SetFlag sets the follow-up flag of the items, ex:
+//
+//	SetFlag(c, itemIds, ews.Flag{FlagStatus: ews.FlagStatusFlagged, StartDate: &start, DueDate: &due})
+func SetFlag(c ews.Client, itemIds []ews.ItemId, flag ews.Flag) error {
+	return updateItems(c, newSetMessageFieldRequest(itemIds, "item:Flag", ews.Message{Flag: &flag}), "flag")
+}
+
+// CompleteFlag marks the follow-up flag of the items as complete at completeDate
+func CompleteFlag(c ews.Client, itemIds []ews.ItemId, completeDate time.Time) error {
+	return SetFlag(c, itemIds, ews.Flag{FlagStatus: ews.FlagStatusComplete, CompleteDate: &completeDate})
+}
+
+// ClearFlag removes the follow-up flag of the items
+func ClearFlag(c ews.Client, itemIds []ews.ItemId) error {
+	return SetFlag(c, itemIds, ews.Flag{FlagStatus: ews.FlagStatusNotFlagged})
+}
+
+// SetImportance sets the importance of the items, one of ews.ImportanceLow, ews.ImportanceNormal
+// or ews.ImportanceHigh
+func SetImportance(c ews.Client, itemIds []ews.ItemId, importance string) error {
+	return updateItems(c, newSetMessageFieldRequest(itemIds, "item:Importance", ews.Message{Importance: utils.Ptr(importance)}), "importance")
+}
+
+// MarkFolderAsRead marks every item of the folder as read, or unread when isRead is false
+func MarkFolderAsRead(c ews.Client, folderId ews.TargetFolderId, isRead bool) error {
+	_, err := ews.MarkAllItemsAsRead(c, ews.NewFolderIds(folderId), isRead, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to mark all items as read")
+	}
+	return nil
+}
+
+// MarkAsJunk marks the items as junk, moving them to Junk Email, or as not junk, moving them
+// back to Inbox, and returns their ids after the move, in the order of itemIds. The id is nil
+// when the item failed to move: the ids of the moved items are returned along with the error
+// of the first item that failed.
+func MarkAsJunk(c ews.Client, itemIds []ews.ItemId, isJunk bool) ([]*ews.ItemId, error) {
+	markAsJunkResponse, err := ews.MarkAsJunk(c, itemIds, isJunk, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to mark as junk")
+	}
+
+	messages := markAsJunkResponse.ResponseMessages.MarkAsJunkResponseMessage
+	if len(messages) != len(itemIds) {
+		return nil, errors.Errorf("expected %d response messages, got %d", len(itemIds), len(messages))
+	}
+
+	movedItemIds := make([]*ews.ItemId, len(messages))
+	var firstErr error
+	for i, message := range messages {
+		if err := message.Err(); err != nil {
+			if firstErr == nil {
+				firstErr = errors.Wrap(err, "failed to mark as junk item "+itemIds[i].Id)
+			}
+			continue
+		}
+		movedItemIds[i] = message.MovedItemId
+	}
+
+	return movedItemIds, firstErr
+}
+
+// newSetMessageFieldRequest sets the same field of every item in one request
+func newSetMessageFieldRequest(itemIds []ews.ItemId, fieldURI string, message ews.Message) *ews.UpdateItemRequest {
+	itemChanges := make([]ews.ItemChange, len(itemIds))
+	for i, itemId := range itemIds {
+		m := message
+		itemChanges[i] = ews.ItemChange{
+			ItemId: itemId,
+			Updates: ews.Updates{
+				SetItemField: []ews.SetItemField{
+					{
+						FieldURI: &ews.FieldURI{FieldURI: fieldURI},
+						Message:  &m,
+					},
+				},
+			},
+		}
+	}
+
+	return &ews.UpdateItemRequest{
+		MessageDisposition: ews.MessageDispositionSaveOnly,
+		ConflictResolution: utils.Ptr(ews.ConflictResolutionAutoResolve),
+		ItemChanges:        ews.ItemChanges{ItemChange: itemChanges},
+	}
+}
+
+func updateItems(c ews.Client, req *ews.UpdateItemRequest, what string) error {
+	if len(req.ItemChanges.ItemChange) == 0 {
+		return nil
+	}
+	_, err := ews.UpdateItem(c, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to update "+what)
+	}
+	return nil
+}
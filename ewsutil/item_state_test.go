@@ -0,0 +1,26 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MarkAsJunk_partialFailure(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:MarkAsJunkResponse><m:ResponseMessages>
+  <m:MarkAsJunkResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:MovedItemId Id="AAMkJunk1" ChangeKey="CK1" />
+  </m:MarkAsJunkResponseMessage>
+  <m:MarkAsJunkResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorItemNotFound</m:ResponseCode></m:MarkAsJunkResponseMessage>
+</m:ResponseMessages></m:MarkAsJunkResponse>`),
+	}}
+
+	movedItemIds, err := MarkAsJunk(c, []ews.ItemId{{Id: "AAMk1"}, {Id: "AAMk2"}}, true)
+	require.Error(t, err)
+	assert.True(t, ews.IsResponseCode(err, "ErrorItemNotFound"))
+	assert.Contains(t, err.Error(), "failed to mark as junk item AAMk2")
+	assert.Equal(t, []*ews.ItemId{{Id: "AAMkJunk1", ChangeKey: "CK1"}, nil}, movedItemIds)
+}
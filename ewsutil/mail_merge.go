@@ -0,0 +1,108 @@
+package ewsutil
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// MailMergeTemplate is rendered with the Data of each MailMergeRecipient, ex:
+//
+//	MailMergeTemplate{
+//		Subject:  "Invoice {{.Number}}",
+//		HTMLBody: "<p>Dear {{.Name}},</p><p>your invoice of {{.Amount}} is attached.</p>",
+//	}
+type MailMergeTemplate struct {
+	// Subject is a text/template
+	Subject string
+	// HTMLBody is an html/template, the data is escaped
+	HTMLBody string
+	// TextBody is a text/template, used when HTMLBody is empty
+	TextBody string
+}
+
+type MailMergeRecipient struct {
+	// To is the RFC 5322 address of the recipient, see ParseAddresses, a list is rejected
+	To   string
+	Data any
+	// Attachments are only sent to this recipient
+	Attachments []ews.FileAttachment
+}
+
+// MailMergeResult is the delivery report of a recipient
+type MailMergeResult struct {
+	To string
+	// ItemId is the id of the sent message, nil when it was not sent
+	ItemId *ews.ItemId
+	Err    error
+}
+
+// MailMerge renders the template for each recipient and sends each message on its own, so
+// recipients never see each other. It returns the result of every recipient, in the order of
+// recipients, and an error only when the template does not parse.
+func MailMerge(c ews.Client, tmpl MailMergeTemplate, recipients []MailMergeRecipient) ([]MailMergeResult, error) {
+	subject, err := template.New("subject").Option("missingkey=error").Parse(tmpl.Subject)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse subject template")
+	}
+
+	var htmlBody *htmltemplate.Template
+	var textBody *template.Template
+	if tmpl.HTMLBody != "" {
+		htmlBody, err = htmltemplate.New("body").Option("missingkey=error").Parse(tmpl.HTMLBody)
+	} else {
+		textBody, err = template.New("body").Option("missingkey=error").Parse(tmpl.TextBody)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse body template")
+	}
+
+	results := make([]MailMergeResult, len(recipients))
+	for i, recipient := range recipients {
+		results[i].To = recipient.To
+
+		// a list would send one message to several recipients, who would see each other
+		mailboxes, err := ParseAddresses(recipient.To)
+		if err != nil {
+			results[i].Err = errors.Wrapf(err, "invalid to address %q", recipient.To)
+			continue
+		}
+		if len(mailboxes) != 1 {
+			results[i].Err = errors.Errorf("to expects a single address, got %q", recipient.To)
+			continue
+		}
+
+		b := NewMessageBuilder().To(recipient.To).Attach(recipient.Attachments...)
+
+		var s strings.Builder
+		if err := subject.Execute(&s, recipient.Data); err != nil {
+			results[i].Err = errors.Wrap(err, "failed to render subject")
+			continue
+		}
+		if strings.ContainsAny(s.String(), "\r\n") {
+			results[i].Err = errors.New("rendered subject spans several lines")
+			continue
+		}
+		b.Subject(s.String())
+
+		var body strings.Builder
+		if htmlBody != nil {
+			err = htmlBody.Execute(&body, recipient.Data)
+			b.HTMLBody(body.String())
+		} else {
+			err = textBody.Execute(&body, recipient.Data)
+			b.TextBody(body.String())
+		}
+		if err != nil {
+			results[i].Err = errors.Wrap(err, "failed to render body")
+			continue
+		}
+
+		results[i].ItemId, results[i].Err = b.Send(c)
+	}
+
+	return results, nil
+}
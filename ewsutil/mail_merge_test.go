@@ -0,0 +1,67 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MailMerge(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(createDraftResponse),
+		soapEnvelope(sendItemResponse),
+	}}
+
+	type invoice struct {
+		Name   string
+		Number int
+	}
+	results, err := MailMerge(c, MailMergeTemplate{
+		Subject:  "Invoice {{.Number}}",
+		HTMLBody: "<p>Dear {{.Name}},</p>",
+	}, []MailMergeRecipient{
+		{
+			To:          "Jane Doe <jane@contoso.com>",
+			Data:        invoice{Name: "Jane <3", Number: 42},
+			Attachments: []ews.FileAttachment{{Name: "invoice-42.pdf", Content: "JVBERi0="}},
+		},
+		{
+			To:   "not an address",
+			Data: invoice{Name: "John", Number: 43},
+		},
+		{
+			To:   "john@contoso.com",
+			Data: map[string]any{"Name": "John"},
+		},
+		{
+			To:   "jane@contoso.com, john@contoso.com",
+			Data: invoice{Name: "Jane", Number: 44},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	assert.Equal(t, "Jane Doe <jane@contoso.com>", results[0].To)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK1"}, results[0].ItemId)
+	assert.Contains(t, c.requests[0], `>Invoice 42</Subject>`)
+	assert.Contains(t, c.requests[0], `&lt;p&gt;Dear Jane &amp;lt;3,&lt;/p&gt;`)
+	assert.Contains(t, c.requests[0], `<Name xmlns="http://schemas.microsoft.com/exchange/services/2006/types">invoice-42.pdf</Name>`)
+	assert.Contains(t, c.requests[0], `<EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">jane@contoso.com</EmailAddress>`)
+
+	require.Error(t, results[1].Err)
+	assert.Contains(t, results[1].Err.Error(), `invalid to address "not an address"`)
+	assert.Nil(t, results[1].ItemId)
+
+	require.Error(t, results[2].Err)
+	assert.Contains(t, results[2].Err.Error(), "failed to render subject")
+
+	assert.EqualError(t, results[3].Err, `to expects a single address, got "jane@contoso.com, john@contoso.com"`)
+	assert.Nil(t, results[3].ItemId)
+	assert.Len(t, c.requests, 2)
+
+	_, err = MailMerge(c, MailMergeTemplate{Subject: "{{.Number"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,326 @@
+package ewsutil
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// MessageBuilder composes an ews.Message. Addresses are RFC 5322 addresses, with or without
+// display name: "jane@contoso.com", "Jane Doe <jane@contoso.com>", or a comma separated list.
+// The first error is kept and returned by Build, Save and Send.
+//
+//	itemId, err := ewsutil.NewMessageBuilder().
+//		To("Jane Doe <jane@contoso.com>").
+//		Cc("support@contoso.com").
+//		Subject("Ticket #42").
+//		HTMLBody("<p>Fixed</p>").
+//		Importance(ews.ImportanceHigh).
+//		Header("X-Ticket-Id", "42").
+//		Send(c)
+type MessageBuilder struct {
+	m          ews.Message
+	mailbox    string // the shared mailbox holding the draft and the sent copy, the caller's when empty
+	onBehalfOf string // the shared mailbox the message is sent on behalf of
+	err        error
+}
+
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// From sets the sender, ex: a shared mailbox the user can send as
+func (b *MessageBuilder) From(address string) *MessageBuilder {
+	mailboxes := b.parseAddresses("from", address)
+	if len(mailboxes) != 1 {
+		return b.fail(errors.New("from expects a single address"))
+	}
+	b.m.From = &ews.OneMailbox{Mailbox: mailboxes[0]}
+	return b
+}
+
+// SendAs sends the message as a shared mailbox: From is set to mailbox, and the draft and the
+// sent copy are saved in the folders of mailbox. The caller needs the Send As permission on
+// mailbox, and full access or folder permissions on its drafts and sent items folders.
+func (b *MessageBuilder) SendAs(mailbox string) *MessageBuilder {
+	b.From(mailbox)
+	if b.m.From != nil {
+		b.mailbox = b.m.From.Mailbox.EmailAddress
+		b.onBehalfOf = ""
+	}
+	return b
+}
+
+// SendOnBehalfOf sends the message on behalf of a shared mailbox: From is set to mailbox and
+// Exchange sets Sender to the caller, so that recipients see "caller on behalf of mailbox".
+// Unlike SendAs, the draft and the sent copy are saved in the caller's own folders. The caller
+// needs the Send on Behalf permission on mailbox.
+func (b *MessageBuilder) SendOnBehalfOf(mailbox string) *MessageBuilder {
+	b.From(mailbox)
+	if b.m.From != nil {
+		b.onBehalfOf = b.m.From.Mailbox.EmailAddress
+		b.mailbox = ""
+	}
+	return b
+}
+
+func (b *MessageBuilder) To(addresses ...string) *MessageBuilder {
+	b.m.ToRecipients = b.appendAddresses(b.m.ToRecipients, "to", addresses)
+	return b
+}
+
+func (b *MessageBuilder) Cc(addresses ...string) *MessageBuilder {
+	b.m.CcRecipients = b.appendAddresses(b.m.CcRecipients, "cc", addresses)
+	return b
+}
+
+func (b *MessageBuilder) Bcc(addresses ...string) *MessageBuilder {
+	b.m.BccRecipients = b.appendAddresses(b.m.BccRecipients, "bcc", addresses)
+	return b
+}
+
+// ReplyTo sets the addresses replies go to instead of the sender
+func (b *MessageBuilder) ReplyTo(addresses ...string) *MessageBuilder {
+	b.m.ReplyTo = b.appendAddresses(b.m.ReplyTo, "reply-to", addresses)
+	return b
+}
+
+func (b *MessageBuilder) Subject(subject string) *MessageBuilder {
+	b.m.Subject = utils.Ptr(subject)
+	return b
+}
+
+func (b *MessageBuilder) HTMLBody(html string) *MessageBuilder {
+	b.m.Body = &ews.Body{BodyType: ews.BodyTypeHTML, Body: []byte(html)}
+	return b
+}
+
+// HTMLBodyWithImages sets an HTML body showing images inline, see EmbedImages
+func (b *MessageBuilder) HTMLBodyWithImages(html string, images ...InlineImage) *MessageBuilder {
+	html, attachments, err := EmbedImages(html, images...)
+	if err != nil {
+		return b.fail(err)
+	}
+	return b.HTMLBody(html).Attach(attachments...)
+}
+
+func (b *MessageBuilder) TextBody(text string) *MessageBuilder {
+	b.m.Body = &ews.Body{BodyType: ews.BodyTypeText, Body: []byte(text)}
+	return b
+}
+
+// Importance is one of ews.ImportanceLow, ews.ImportanceNormal or ews.ImportanceHigh
+func (b *MessageBuilder) Importance(importance string) *MessageBuilder {
+	switch importance {
+	case ews.ImportanceLow, ews.ImportanceNormal, ews.ImportanceHigh:
+		b.m.Importance = utils.Ptr(importance)
+		return b
+	}
+	return b.fail(errors.Errorf("invalid importance %q", importance))
+}
+
+// Sensitivity is one of ews.SensitivityNormal, ews.SensitivityPersonal, ews.SensitivityPrivate
+// or ews.SensitivityConfidential
+func (b *MessageBuilder) Sensitivity(sensitivity string) *MessageBuilder {
+	switch sensitivity {
+	case ews.SensitivityNormal, ews.SensitivityPersonal, ews.SensitivityPrivate, ews.SensitivityConfidential:
+		b.m.Sensitivity = utils.Ptr(sensitivity)
+		return b
+	}
+	return b.fail(errors.Errorf("invalid sensitivity %q", sensitivity))
+}
+
+func (b *MessageBuilder) Categories(categories ...string) *MessageBuilder {
+	if b.m.Categories == nil {
+		b.m.Categories = &ews.Categories{}
+	}
+	b.m.Categories.String = append(b.m.Categories.String, categories...)
+	return b
+}
+
+func (b *MessageBuilder) RequestReadReceipt() *MessageBuilder {
+	b.m.IsReadReceiptRequested = utils.Ptr(true)
+	return b
+}
+
+func (b *MessageBuilder) RequestDeliveryReceipt() *MessageBuilder {
+	b.m.IsDeliveryReceiptRequested = utils.Ptr(true)
+	return b
+}
+
+// Header adds a custom internet header, Exchange only keeps the X- headers
+func (b *MessageBuilder) Header(name, value string) *MessageBuilder {
+	if name == "" || strings.ContainsAny(name, ": \t\r\n") {
+		return b.fail(errors.Errorf("invalid header name %q", name))
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return b.fail(errors.Errorf("invalid value for header %s", name))
+	}
+	return b.ExtendedProperty(ews.ExtendedFieldURI{
+		DistinguishedPropertySetId: ews.DistinguishedPropertySetIdInternetHeaders,
+		PropertyName:               name,
+		PropertyType:               ews.PropertyTypeString,
+	}, value)
+}
+
+// ExtendedProperty sets a MAPI property of the message
+func (b *MessageBuilder) ExtendedProperty(extendedFieldURI ews.ExtendedFieldURI, value string) *MessageBuilder {
+	b.m.ExtendedProperties = append(b.m.ExtendedProperties, ews.ExtendedProperty{
+		ExtendedFieldURI: &extendedFieldURI,
+		Value:            utils.Ptr(value),
+	})
+	return b
+}
+
+func (b *MessageBuilder) Attach(attachments ...ews.FileAttachment) *MessageBuilder {
+	if len(attachments) == 0 {
+		return b
+	}
+	if b.m.Attachments == nil {
+		b.m.Attachments = &ews.Attachments{}
+	}
+	b.m.Attachments.FileAttachment = append(b.m.Attachments.FileAttachment, attachments...)
+	return b
+}
+
+// Build returns the composed message, checking the cid: references of an HTML body resolve
+// to inline attachments
+func (b *MessageBuilder) Build() (ews.Message, error) {
+	if b.err != nil {
+		return ews.Message{}, b.err
+	}
+	if b.m.Body != nil && b.m.Body.BodyType == ews.BodyTypeHTML {
+		var attachments []ews.FileAttachment
+		if b.m.Attachments != nil {
+			attachments = b.m.Attachments.FileAttachment
+		}
+		if err := ValidateContentIds(string(b.m.Body.Body), attachments); err != nil {
+			return ews.Message{}, err
+		}
+	}
+	return b.m, nil
+}
+
+// Save saves the message in the drafts folder and returns its id
+func (b *MessageBuilder) Save(c ews.Client) (*ews.ItemId, error) {
+	m, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	draftsFolderId := ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdDrafts, mailboxEmail(b.mailbox))
+	itemId, err := ews.CreateMessageItem(c, m, ews.CreateItemRequestConfig{
+		MessageDisposition: ews.MessageDispositionSaveOnly,
+		SavedItemFolderId:  &draftsFolderId,
+	})
+	if err != nil {
+		return nil, errors.Wrap(b.permissionError(err), "failed to save message")
+	}
+	return itemId, nil
+}
+
+// Send sends the message and keeps a copy in the sent items folder, like SendEmail
+func (b *MessageBuilder) Send(c ews.Client) (*ews.ItemId, error) {
+	m, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	if m.ToRecipients == nil && m.CcRecipients == nil && m.BccRecipients == nil {
+		return nil, errors.New("message has no recipient")
+	}
+
+	itemId, err := sendEmailWithSaveThenSend(c, m, b.mailbox)
+	if err != nil {
+		return nil, errors.Wrap(b.permissionError(err), "failed to send email")
+	}
+	return itemId, nil
+}
+
+// SendIdempotent sends the message at most once per key, see SendEmailIdempotent
+func (b *MessageBuilder) SendIdempotent(c ews.Client, key string) (*ews.ItemId, error) {
+	m, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	if m.ToRecipients == nil && m.CcRecipients == nil && m.BccRecipients == nil {
+		return nil, errors.New("message has no recipient")
+	}
+
+	itemId, err := sendEmailIdempotent(c, m, key, b.mailbox)
+	if err != nil {
+		return nil, errors.Wrap(b.permissionError(err), "failed to send email")
+	}
+	return itemId, nil
+}
+
+// Schedule sends the message at sendAt, see ScheduleEmail
+func (b *MessageBuilder) Schedule(c ews.Client, sendAt time.Time) (*ews.ItemId, error) {
+	m, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+	itemId, err := scheduleEmail(c, m, sendAt, b.mailbox)
+	if err != nil {
+		return nil, onBehalfOfError(b.onBehalfOf, err)
+	}
+	return itemId, nil
+}
+
+// permissionError explains the errors caused by missing permissions on the shared mailbox
+// the message is sent as or on behalf of
+func (b *MessageBuilder) permissionError(err error) error {
+	if b.onBehalfOf != "" {
+		return onBehalfOfError(b.onBehalfOf, err)
+	}
+	return sharedMailboxError(b.mailbox, err)
+}
+
+func (b *MessageBuilder) fail(err error) *MessageBuilder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+func (b *MessageBuilder) appendAddresses(recipients *ews.XMailbox, field string, addresses []string) *ews.XMailbox {
+	var mailboxes []ews.Mailbox
+	for _, address := range addresses {
+		mailboxes = append(mailboxes, b.parseAddresses(field, address)...)
+	}
+	if len(mailboxes) == 0 {
+		return recipients
+	}
+	if recipients == nil {
+		recipients = &ews.XMailbox{}
+	}
+	recipients.Mailbox = append(recipients.Mailbox, mailboxes...)
+	return recipients
+}
+
+func (b *MessageBuilder) parseAddresses(field, address string) []ews.Mailbox {
+	addresses, err := ParseAddresses(address)
+	if err != nil {
+		b.fail(errors.Wrapf(err, "invalid %s address %q", field, address))
+		return nil
+	}
+	return addresses
+}
+
+// ParseAddresses parses a comma separated list of RFC 5322 addresses into mailboxes,
+// keeping the display names
+func ParseAddresses(list string) ([]ews.Mailbox, error) {
+	addresses, err := mail.ParseAddressList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	mailboxes := make([]ews.Mailbox, len(addresses))
+	for i, address := range addresses {
+		mailboxes[i] = ews.Mailbox{Name: address.Name, EmailAddress: address.Address}
+	}
+	return mailboxes, nil
+}
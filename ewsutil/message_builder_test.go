@@ -0,0 +1,70 @@
+package ewsutil
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MessageBuilder(t *testing.T) {
+	m, err := NewMessageBuilder().
+		To("Jane Doe <jane@contoso.com>, john@contoso.com").
+		Bcc("audit@contoso.com").
+		ReplyTo(`"Support, Contoso" <support@contoso.com>`).
+		Subject("Ticket #42").
+		TextBody("Fixed").
+		Importance(ews.ImportanceHigh).
+		RequestDeliveryReceipt().
+		Header("X-Ticket-Id", "42").
+		Build()
+	require.NoError(t, err)
+
+	xmlBytes, err := xml.MarshalIndent(m, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<Message>
+  <Subject xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Ticket #42</Subject>
+  <Body xmlns="http://schemas.microsoft.com/exchange/services/2006/types" BodyType="Text">Fixed</Body>
+  <Importance xmlns="http://schemas.microsoft.com/exchange/services/2006/types">High</Importance>
+  <ExtendedProperty xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+    <ExtendedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" DistinguishedPropertySetId="InternetHeaders" PropertyType="String" PropertyName="X-Ticket-Id"></ExtendedFieldURI>
+    <Value xmlns="http://schemas.microsoft.com/exchange/services/2006/types">42</Value>
+  </ExtendedProperty>
+  <ToRecipients xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+    <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <Name xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Jane Doe</Name>
+      <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">jane@contoso.com</EmailAddress>
+    </Mailbox>
+    <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">john@contoso.com</EmailAddress>
+    </Mailbox>
+  </ToRecipients>
+  <BccRecipients xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+    <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">audit@contoso.com</EmailAddress>
+    </Mailbox>
+  </BccRecipients>
+  <IsDeliveryReceiptRequested xmlns="http://schemas.microsoft.com/exchange/services/2006/types">true</IsDeliveryReceiptRequested>
+  <ReplyTo xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+    <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <Name xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Support, Contoso</Name>
+      <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@contoso.com</EmailAddress>
+    </Mailbox>
+  </ReplyTo>
+</Message>`, string(xmlBytes))
+}
+
+func Test_MessageBuilder_errors(t *testing.T) {
+	_, err := NewMessageBuilder().To("jane@").Importance("Urgent").Build()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid to address "jane@"`)
+
+	_, err = NewMessageBuilder().Importance("Urgent").Build()
+	assert.EqualError(t, err, `invalid importance "Urgent"`)
+
+	_, err = NewMessageBuilder().Header("X-Bad: header", "1").Build()
+	assert.EqualError(t, err, `invalid header name "X-Bad: header"`)
+}
@@ -0,0 +1,115 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// DefaultModifyItemAttempts is the number of attempts of ModifyItem when the config sets none
+const DefaultModifyItemAttempts = 3
+
+// SkipUpdate is returned by an ItemMutation when the item already holds the wanted values,
+// ModifyItem then returns without sending an update
+var SkipUpdate = errors.New("skip update")
+
+// ItemMutation adds to b the changes to apply to the current version of the item,
+// items holds the item as fetched with the ModifyItemConfig.ItemShape
+type ItemMutation func(items ews.Items, b *ews.UpdateItemBuilder) error
+
+type ModifyItemConfig struct {
+	// ItemType defaults to ews.ItemTypeMessage
+	ItemType ews.ItemType
+	// ItemShape defaults to the IdOnly shape, ask for the properties the mutation reads
+	ItemShape *ews.ItemShape
+	// ConflictResolution defaults to NeverOverwrite, AutoResolve lets the server merge
+	// changes to distinct properties
+	ConflictResolution ews.ConflictResolution
+	// MaxAttempts defaults to DefaultModifyItemAttempts
+	MaxAttempts int
+}
+
+// ModifyItem applies mutate to an item: it fetches the item and sends the changes along with the
+// ChangeKey of itemId, or of the fetched item when itemId has none, so that the update fails when
+// another writer changed the item since the caller read it. On a conflict it fetches the item again
+// and reapplies mutate with the new ChangeKey, up to MaxAttempts times. It returns the item id with
+// its new ChangeKey.
+func ModifyItem(c ews.Client, itemId ews.ItemId, config ModifyItemConfig, mutate ItemMutation) (*ews.ItemId, error) {
+	if config.ItemType == "" {
+		config.ItemType = ews.ItemTypeMessage
+	}
+	if config.ItemShape == nil {
+		config.ItemShape = &ews.ItemShape{BaseShape: ews.BaseShapeIdOnly}
+	}
+	if config.ConflictResolution == "" {
+		config.ConflictResolution = ews.ConflictResolutionNeverOverwrite
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultModifyItemAttempts
+	}
+
+	// the first attempt checks the version the caller read, the next ones the version just fetched
+	changeKey := itemId.ChangeKey
+	var err error
+	for attempt := 0; attempt < config.MaxAttempts; attempt++ {
+		var newItemId *ews.ItemId
+		newItemId, err = modifyItem(c, ews.ItemId{Id: itemId.Id}, changeKey, config, mutate)
+		changeKey = ""
+		if err == nil {
+			return newItemId, nil
+		}
+		if !isConflict(err) {
+			return nil, err
+		}
+	}
+
+	return nil, errors.Wrapf(err, "failed to update item after %d attempts", config.MaxAttempts)
+}
+
+// modifyItem fetches the item and sends the changes of mutate along with changeKey, or the
+// ChangeKey of the fetched item when empty
+func modifyItem(c ews.Client, itemId ews.ItemId, changeKey string, config ModifyItemConfig, mutate ItemMutation) (*ews.ItemId, error) {
+	getItemResponse, err := ews.GetItem(c, itemId, ews.GetItemRequestConfig{ItemShape: config.ItemShape})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get item")
+	}
+
+	items := getItemResponse.ResponseMessages.GetItemResponseMessage.Items
+	itemIds := items.ItemIds()
+	if len(itemIds) != 1 {
+		return nil, errors.Errorf("expected 1 item, got %d", len(itemIds))
+	}
+	current := itemIds[0]
+	if current.ChangeKey == "" {
+		return nil, errors.New("item has no change key")
+	}
+	if changeKey != "" {
+		current.ChangeKey = changeKey
+	}
+
+	b := ews.NewUpdateItemBuilder().
+		ConflictResolution(config.ConflictResolution).
+		Item(current, config.ItemType)
+	if err := mutate(items, b); err != nil {
+		if errors.Is(err, SkipUpdate) {
+			return &current, nil
+		}
+		return nil, err
+	}
+
+	newItemIds, err := b.Send(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update item")
+	}
+	if len(newItemIds) != 1 || newItemIds[0] == nil {
+		// the server may leave the updated item out of the response
+		return &current, nil
+	}
+	return newItemIds[0], nil
+}
+
+// isConflict tells whether the item changed since it was fetched
+func isConflict(err error) bool {
+	return ews.IsResponseCode(err, "ErrorIrresolvableConflict") ||
+		ews.IsResponseCode(err, "ErrorChangeKeyRequiredForWriteOperations") ||
+		ews.IsResponseCode(err, "ErrorStaleObject")
+}
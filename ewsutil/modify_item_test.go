@@ -0,0 +1,93 @@
+package ewsutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getItemResponse(changeKey string) string {
+	return soapEnvelope(`<m:GetItemResponse><m:ResponseMessages>
+  <m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkMessage" ChangeKey="` + changeKey + `" /></t:Message></m:Items>
+  </m:GetItemResponseMessage>
+</m:ResponseMessages></m:GetItemResponse>`)
+}
+
+func Test_ModifyItem_retriesOnConflict(t *testing.T) {
+	c := &stubClient{responses: []string{
+		getItemResponse("CK1"),
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Error">
+    <m:MessageText>The change key is stale.</m:MessageText>
+    <m:ResponseCode>ErrorIrresolvableConflict</m:ResponseCode>
+  </m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+		getItemResponse("CK2"),
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkMessage" ChangeKey="CK3" /></t:Message></m:Items>
+  </m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+	}}
+
+	var mutations int
+	itemId, err := ModifyItem(c, ews.ItemId{Id: "AAMkMessage"}, ModifyItemConfig{}, func(_ ews.Items, b *ews.UpdateItemBuilder) error {
+		mutations++
+		b.Set("message:IsRead", true)
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, &ews.ItemId{Id: "AAMkMessage", ChangeKey: "CK3"}, itemId)
+	assert.Equal(t, 2, mutations)
+	require.Len(t, c.requests, 4)
+	assert.Contains(t, c.requests[1], `ConflictResolution="NeverOverwrite"`)
+	assert.Contains(t, c.requests[1], `ChangeKey="CK1"`)
+	assert.Contains(t, c.requests[3], `ChangeKey="CK2"`)
+}
+
+func Test_ModifyItem_giveUp(t *testing.T) {
+	conflict := soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorIrresolvableConflict</m:ResponseCode></m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`)
+	c := &stubClient{responses: []string{getItemResponse("CK1"), conflict, getItemResponse("CK2"), conflict}}
+
+	_, err := ModifyItem(c, ews.ItemId{Id: "AAMkMessage"}, ModifyItemConfig{MaxAttempts: 2}, func(_ ews.Items, b *ews.UpdateItemBuilder) error {
+		b.Set("message:IsRead", true)
+		return nil
+	})
+	require.Error(t, err)
+	assert.True(t, ews.IsResponseCode(err, "ErrorIrresolvableConflict"))
+	assert.True(t, strings.HasPrefix(err.Error(), "failed to update item after 2 attempts"))
+}
+
+func Test_ModifyItem_skipUpdate(t *testing.T) {
+	c := &stubClient{responses: []string{getItemResponse("CK1")}}
+
+	itemId, err := ModifyItem(c, ews.ItemId{Id: "AAMkMessage"}, ModifyItemConfig{}, func(ews.Items, *ews.UpdateItemBuilder) error {
+		return SkipUpdate
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkMessage", ChangeKey: "CK1"}, itemId)
+	assert.Len(t, c.requests, 1)
+}
+
+func Test_ModifyItem_sendsCallerChangeKey(t *testing.T) {
+	conflict := soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorIrresolvableConflict</m:ResponseCode></m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`)
+	c := &stubClient{responses: []string{getItemResponse("CK1"), conflict, getItemResponse("CK1"), conflict}}
+
+	_, err := ModifyItem(c, ews.ItemId{Id: "AAMkMessage", ChangeKey: "CK0"}, ModifyItemConfig{MaxAttempts: 2}, func(_ ews.Items, b *ews.UpdateItemBuilder) error {
+		b.Set("message:IsRead", true)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, c.requests[0], `<ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkMessage"></ItemId>`)
+	assert.Contains(t, c.requests[1], `ChangeKey="CK0"`)
+	assert.Contains(t, c.requests[3], `ChangeKey="CK1"`)
+}
@@ -0,0 +1,67 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// MoveItems moves the items into toFolderId and returns their new ids, in the order of itemIds.
+// The new id is nil when the server does not return it, ex: when moving to another mailbox, or
+// when the item failed to move: the ids of the moved items are returned along with the error
+// of the first item that failed.
+func MoveItems(c ews.Client, itemIds []ews.ItemId, toFolderId ews.TargetFolderId) ([]*ews.ItemId, error) {
+	moveItemResponse, err := ews.MoveItem(c, itemIds, toFolderId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to move items")
+	}
+
+	messages := moveItemResponse.ResponseMessages.ResponseMessage
+	if len(messages) != len(itemIds) {
+		return nil, errors.Errorf("expected %d response messages, got %d", len(itemIds), len(messages))
+	}
+
+	newItemIds := make([]*ews.ItemId, len(messages))
+	var firstErr error
+	for i, message := range messages {
+		if err := message.Err(); err != nil {
+			if firstErr == nil {
+				firstErr = errors.Wrap(err, "failed to move item "+itemIds[i].Id)
+			}
+			continue
+		}
+		if ids := message.Items.ItemIds(); len(ids) == 1 {
+			newItemIds[i] = &ids[0]
+		}
+	}
+
+	return newItemIds, firstErr
+}
+
+// MoveMessageByInternetMessageId moves the messages of fromFolderId having the given
+// Internet Message-ID, ex: <id@mail.gmail.com>, into toFolderId and returns their new ids
+func MoveMessageByInternetMessageId(c ews.Client, internetMessageId string, fromFolderId, toFolderId ews.TargetFolderId) ([]*ews.ItemId, error) {
+	findItemResponse, err := ews.FindItemInFolders(c, ews.NewFolderIds(fromFolderId), ews.FindItemRequestConfig{
+		Traversal: utils.Ptr(ews.FindItemTraversalShallow),
+		BaseShape: utils.Ptr(ews.BaseShapeIdOnly),
+		Restriction: &ews.Restriction{
+			IsEqualTo: &ews.IsEqualTo{
+				ExtendedFieldURI: &ews.ExtendedFieldURI{
+					PropertyTag:  ews.PropertyTagInternetMessageId,
+					PropertyType: ews.PropertyTypeString,
+				},
+				FieldURIOrConstant: ews.NewConstant(internetMessageId),
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find item")
+	}
+
+	itemIds := findItemResponse.ResponseMessages.FindItemResponseMessage[0].RootFolder.Items.ItemIds()
+	if len(itemIds) == 0 {
+		return nil, errors.New("no message found with internet message id " + internetMessageId)
+	}
+
+	return MoveItems(c, itemIds, toFolderId)
+}
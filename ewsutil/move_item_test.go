@@ -0,0 +1,29 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MoveItems_partialFailure(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:MoveItemResponse><m:ResponseMessages>
+  <m:MoveItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkMoved1" ChangeKey="CK1" /></t:Message></m:Items>
+  </m:MoveItemResponseMessage>
+  <m:MoveItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorItemNotFound</m:ResponseCode></m:MoveItemResponseMessage>
+  <m:MoveItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkMoved3" ChangeKey="CK3" /></t:Message></m:Items>
+  </m:MoveItemResponseMessage>
+</m:ResponseMessages></m:MoveItemResponse>`),
+	}}
+
+	newItemIds, err := MoveItems(c, []ews.ItemId{{Id: "AAMk1"}, {Id: "AAMk2"}, {Id: "AAMk3"}}, ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdDeletedItems, nil))
+	require.Error(t, err)
+	assert.True(t, ews.IsResponseCode(err, "ErrorItemNotFound"))
+	assert.Contains(t, err.Error(), "failed to move item AAMk2")
+	assert.Equal(t, []*ews.ItemId{{Id: "AAMkMoved1", ChangeKey: "CK1"}, nil, {Id: "AAMkMoved3", ChangeKey: "CK3"}}, newItemIds)
+}
@@ -0,0 +1,379 @@
+package ewsutil
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultOutboxConcurrency  = 4
+	DefaultOutboxMaxAttempts  = 5
+	DefaultOutboxBackoff      = time.Minute
+	DefaultOutboxMaxBackoff   = time.Hour
+	DefaultOutboxPollInterval = 30 * time.Second
+)
+
+type OutboxStatus string
+
+const (
+	// OutboxStatusPending entries are sent when their NextAttempt is due
+	OutboxStatusPending OutboxStatus = "Pending"
+	// OutboxStatusFailed entries were rejected by the server or ran out of attempts
+	OutboxStatusFailed OutboxStatus = "Failed"
+)
+
+// OutboxEntry is a message waiting in an Outbox, sent entries are removed from the store
+type OutboxEntry struct {
+	// Id is also the idempotency key the message is sent with, see SendEmailIdempotent
+	Id          string
+	Message     ews.Message
+	Status      OutboxStatus
+	Attempts    int
+	CreatedAt   time.Time
+	NextAttempt time.Time
+	LastError   string
+	// ResponseCode is the EWS response code of the last failure, empty when the request
+	// failed without a response from the server
+	ResponseCode string
+}
+
+// OutboxStore persists the entries of an Outbox, it must be safe for concurrent use
+type OutboxStore interface {
+	Save(entry OutboxEntry) error
+	// List returns the entries in the order they were created
+	List() ([]OutboxEntry, error)
+	Delete(id string) error
+}
+
+// FileOutboxStore keeps each entry in a JSON file of a directory
+type FileOutboxStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func NewFileOutboxStore(dir string) (*FileOutboxStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errors.Wrap(err, "failed to create outbox directory")
+	}
+	return &FileOutboxStore{dir: dir}, nil
+}
+
+func (s *FileOutboxStore) Save(entry OutboxEntry) error {
+	path, err := s.path(entry.Id)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode outbox entry")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// write then rename, so that a crash never leaves a truncated entry
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write outbox entry")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrap(err, "failed to write outbox entry")
+	}
+	return nil
+}
+
+func (s *FileOutboxStore) List() ([]OutboxEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read outbox directory")
+	}
+
+	var entries []OutboxEntry
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, file.Name()))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read outbox entry")
+		}
+		var entry OutboxEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode outbox entry %s", file.Name())
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+	return entries, nil
+}
+
+func (s *FileOutboxStore) Delete(id string) error {
+	path, err := s.path(id)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete outbox entry")
+	}
+	return nil
+}
+
+func (s *FileOutboxStore) path(id string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) || id == "." || id == ".." {
+		return "", errors.Errorf("invalid outbox entry id %q", id)
+	}
+	return filepath.Join(s.dir, id+".json"), nil
+}
+
+type OutboxConfig struct {
+	// Concurrency is the number of messages sent at the same time, DefaultOutboxConcurrency when 0
+	Concurrency int
+	// MinInterval is the minimum time between the start of two sends, no limit when 0
+	MinInterval time.Duration
+	// MaxAttempts is the number of sends before a message is marked failed, DefaultOutboxMaxAttempts when 0
+	MaxAttempts int
+	// Backoff is the wait before the first retry, doubled on every retry up to MaxBackoff,
+	// DefaultOutboxBackoff and DefaultOutboxMaxBackoff when 0
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// PollInterval is the wait of Run between two passes, DefaultOutboxPollInterval when 0
+	PollInterval time.Duration
+}
+
+// Outbox queues messages in a store and sends them in the background, so that the queued
+// messages survive a restart of the process. Failures without a response from the server,
+// and the responses of a busy or unavailable server, are retried with back-off, the other
+// failures are kept in the store with their response code, see Failed.
+//
+// The messages are sent with SendEmailIdempotent keyed by the entry id: a message sent right
+// before a crash is found in the sent items on the next attempt instead of being sent twice.
+//
+//	store, err := ewsutil.NewFileOutboxStore("/var/lib/app/outbox")
+//	outbox := ewsutil.NewOutbox(c, store, ewsutil.OutboxConfig{MinInterval: time.Second})
+//	go outbox.Run(ctx)
+//	id, err := outbox.Enqueue(m)
+type Outbox struct {
+	c      ews.Client
+	store  OutboxStore
+	config OutboxConfig
+
+	// serializes the passes over the store
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+func NewOutbox(c ews.Client, store OutboxStore, config OutboxConfig) *Outbox {
+	if config.Concurrency <= 0 {
+		config.Concurrency = DefaultOutboxConcurrency
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultOutboxMaxAttempts
+	}
+	if config.Backoff <= 0 {
+		config.Backoff = DefaultOutboxBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultOutboxMaxBackoff
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultOutboxPollInterval
+	}
+	return &Outbox{c: c, store: store, config: config, now: time.Now}
+}
+
+// Enqueue stores m to be sent and returns the id of its entry
+func (o *Outbox) Enqueue(m ews.Message) (string, error) {
+	if m.ToRecipients == nil && m.CcRecipients == nil && m.BccRecipients == nil {
+		return "", errors.New("message has no recipient")
+	}
+
+	now := o.now()
+	entry := OutboxEntry{
+		Id:          uuid.NewString(),
+		Message:     m,
+		Status:      OutboxStatusPending,
+		CreatedAt:   now,
+		NextAttempt: now,
+	}
+	if err := o.store.Save(entry); err != nil {
+		return "", err
+	}
+	return entry.Id, nil
+}
+
+// Failed returns the entries that will not be sent
+func (o *Outbox) Failed() ([]OutboxEntry, error) {
+	entries, err := o.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []OutboxEntry
+	for _, entry := range entries {
+		if entry.Status == OutboxStatusFailed {
+			failed = append(failed, entry)
+		}
+	}
+	return failed, nil
+}
+
+// Run sends the due messages every PollInterval until ctx is done
+func (o *Outbox) Run(ctx context.Context) error {
+	for {
+		if err := o.ProcessPending(ctx); err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(o.config.PollInterval):
+		}
+	}
+}
+
+// ProcessPending sends the pending messages that are due and returns once they are sent,
+// rescheduled or marked failed. It returns the first error of the store.
+func (o *Outbox) ProcessPending(ctx context.Context) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	entries, err := o.store.List()
+	if err != nil {
+		return err
+	}
+
+	now := o.now()
+	due := make(chan OutboxEntry)
+	go func() {
+		defer close(due)
+		for _, entry := range entries {
+			if entry.Status != OutboxStatusPending || entry.NextAttempt.After(now) {
+				continue
+			}
+			select {
+			case due <- entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var limiter <-chan time.Time
+	if o.config.MinInterval > 0 {
+		ticker := time.NewTicker(o.config.MinInterval)
+		defer ticker.Stop()
+		limiter = ticker.C
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		firstErr error
+	)
+	for i := 0; i < o.config.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range due {
+				if limiter != nil {
+					select {
+					case <-limiter:
+					case <-ctx.Done():
+						continue
+					}
+				}
+				if err := o.send(entry); err != nil {
+					errMu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// send sends the message of entry, then removes entry from the store or records the failure
+func (o *Outbox) send(entry OutboxEntry) error {
+	_, err := sendEmailIdempotent(o.c, entry.Message, entry.Id, "")
+	if err == nil {
+		return o.store.Delete(entry.Id)
+	}
+
+	entry.Attempts++
+	entry.LastError = err.Error()
+	entry.ResponseCode = ""
+
+	transient := true
+	var responseError *ews.ResponseError
+	var soapError *ews.SoapError
+	if errors.As(err, &responseError) {
+		entry.ResponseCode = responseError.ResponseCode
+		transient = isTransientResponseCode(responseError.ResponseCode)
+	} else if errors.As(err, &soapError) && soapError.Fault != nil && soapError.Fault.Detail.ResponseCode != "" {
+		// the server rejected the whole request, ex: ErrorServerBusy when throttled
+		entry.ResponseCode = soapError.Fault.Detail.ResponseCode
+		transient = isTransientResponseCode(entry.ResponseCode)
+	}
+
+	if !transient || entry.Attempts >= o.config.MaxAttempts {
+		entry.Status = OutboxStatusFailed
+	} else {
+		entry.NextAttempt = o.now().Add(o.backoff(entry.Attempts))
+	}
+	return o.store.Save(entry)
+}
+
+// backoff returns the wait before the retry following the given number of attempts
+func (o *Outbox) backoff(attempts int) time.Duration {
+	backoff := o.config.Backoff
+	for i := 1; i < attempts && backoff < o.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > o.config.MaxBackoff {
+		backoff = o.config.MaxBackoff
+	}
+	return backoff
+}
+
+// isTransientResponseCode reports whether the request may succeed when sent again later
+func isTransientResponseCode(responseCode string) bool {
+	switch responseCode {
+	case "ErrorServerBusy",
+		"ErrorTimeoutExpired",
+		"ErrorInternalServerTransientError",
+		"ErrorMailboxStoreUnavailable",
+		"ErrorMailboxMoveInProgress",
+		"ErrorConnectionFailed":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package ewsutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestOutbox(t *testing.T, c ews.Client) (*Outbox, *FileOutboxStore) {
+	store, err := NewFileOutboxStore(t.TempDir())
+	require.NoError(t, err)
+
+	outbox := NewOutbox(c, store, OutboxConfig{Concurrency: 1})
+	now := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+	outbox.now = func() time.Time { return now }
+	return outbox, store
+}
+
+func testOutboxMessage() ews.Message {
+	return ews.Message{
+		Subject:      utils.Ptr("Your invoice"),
+		ToRecipients: &ews.XMailbox{Mailbox: []ews.Mailbox{{EmailAddress: "customer@contoso.com"}}},
+	}
+}
+
+func Test_FileOutboxStore(t *testing.T) {
+	store, err := NewFileOutboxStore(t.TempDir())
+	require.NoError(t, err)
+
+	first := OutboxEntry{Id: "first", Message: testOutboxMessage(), Status: OutboxStatusPending, CreatedAt: time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)}
+	second := OutboxEntry{Id: "second", Status: OutboxStatusFailed, CreatedAt: first.CreatedAt.Add(time.Second), ResponseCode: "ErrorInvalidRecipients"}
+	require.NoError(t, store.Save(second))
+	require.NoError(t, store.Save(first))
+
+	entries, err := store.List()
+	require.NoError(t, err)
+	assert.Equal(t, []OutboxEntry{first, second}, entries)
+
+	require.NoError(t, store.Delete("first"))
+	require.NoError(t, store.Delete("first"))
+	entries, err = store.List()
+	require.NoError(t, err)
+	assert.Equal(t, []OutboxEntry{second}, entries)
+
+	assert.Error(t, store.Save(OutboxEntry{Id: "../escape"}))
+}
+
+func Test_Outbox_sends(t *testing.T) {
+	c := &stubClient{responses: []string{
+		findItemResponse(),
+		findItemResponse(),
+		findItemResponse(),
+		soapEnvelope(createDraftResponse),
+		soapEnvelope(sendItemResponse),
+	}}
+	outbox, store := newTestOutbox(t, c)
+
+	id, err := outbox.Enqueue(testOutboxMessage())
+	require.NoError(t, err)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+
+	assert.Contains(t, c.requests[0], `Value="`+id+`"`)
+	entries, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = outbox.Enqueue(ews.Message{})
+	assert.EqualError(t, err, "message has no recipient")
+}
+
+func Test_Outbox_retriesTransientFailures(t *testing.T) {
+	c := &timeoutClient{stubClient: &stubClient{responses: []string{
+		findItemResponse(),
+		findItemResponse(),
+		findItemResponse(),
+		soapEnvelope(createDraftResponse),
+		findItemResponse(),
+		findItemResponse(),
+	}}, failAt: 4}
+	outbox, store := newTestOutbox(t, c)
+
+	id, err := outbox.Enqueue(testOutboxMessage())
+	require.NoError(t, err)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+
+	entries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, id, entries[0].Id)
+	assert.Equal(t, OutboxStatusPending, entries[0].Status)
+	assert.Equal(t, 1, entries[0].Attempts)
+	assert.Equal(t, outbox.now().Add(DefaultOutboxBackoff), entries[0].NextAttempt)
+	assert.Contains(t, entries[0].LastError, "context deadline exceeded")
+
+	// not due yet
+	requests := len(c.requests)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+	assert.Len(t, c.requests, requests)
+
+	assert.Equal(t, 4*time.Minute, outbox.backoff(3))
+	assert.Equal(t, DefaultOutboxMaxBackoff, outbox.backoff(20))
+}
+
+func Test_Outbox_recordsPermanentFailures(t *testing.T) {
+	c := &stubClient{responses: []string{
+		findItemResponse(),
+		findItemResponse(),
+		findItemResponse(),
+		soapEnvelope(`<m:CreateItemResponse><m:ResponseMessages>
+  <m:CreateItemResponseMessage ResponseClass="Error">
+    <m:MessageText>At least one recipient isn't valid.</m:MessageText>
+    <m:ResponseCode>ErrorInvalidRecipients</m:ResponseCode>
+  </m:CreateItemResponseMessage>
+</m:ResponseMessages></m:CreateItemResponse>`),
+	}}
+	outbox, _ := newTestOutbox(t, c)
+
+	_, err := outbox.Enqueue(testOutboxMessage())
+	require.NoError(t, err)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+
+	failed, err := outbox.Failed()
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, OutboxStatusFailed, failed[0].Status)
+	assert.Equal(t, "ErrorInvalidRecipients", failed[0].ResponseCode)
+	assert.Equal(t, 1, failed[0].Attempts)
+}
+
+// faultClient rejects every request with a SOAP fault of the given response code
+type faultClient struct {
+	*stubClient
+	responseCode string
+}
+
+func (s *faultClient) SendAndReceive(body []byte) ([]byte, error) {
+	s.requests = append(s.requests, string(body))
+	fault := &ews.Fault{Faultcode: "a:" + s.responseCode, Faultstring: "The request was rejected."}
+	fault.Detail.ResponseCode = s.responseCode
+	return nil, &ews.SoapError{Fault: fault}
+}
+
+func Test_Outbox_classifiesSoapFaults(t *testing.T) {
+	outbox, store := newTestOutbox(t, &faultClient{stubClient: &stubClient{}, responseCode: "ErrorServerBusy"})
+	_, err := outbox.Enqueue(testOutboxMessage())
+	require.NoError(t, err)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+
+	entries, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, OutboxStatusPending, entries[0].Status)
+	assert.Equal(t, "ErrorServerBusy", entries[0].ResponseCode)
+
+	outbox, _ = newTestOutbox(t, &faultClient{stubClient: &stubClient{}, responseCode: "ErrorInvalidRequest"})
+	_, err = outbox.Enqueue(testOutboxMessage())
+	require.NoError(t, err)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+
+	failed, err := outbox.Failed()
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "ErrorInvalidRequest", failed[0].ResponseCode)
+}
+
+func Test_Outbox_recordsSearchFailures(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:FindItemResponse><m:ResponseMessages>
+  <m:FindItemResponseMessage ResponseClass="Error">
+    <m:MessageText>Access is denied.</m:MessageText>
+    <m:ResponseCode>ErrorAccessDenied</m:ResponseCode>
+  </m:FindItemResponseMessage>
+</m:ResponseMessages></m:FindItemResponse>`),
+	}}
+	outbox, _ := newTestOutbox(t, c)
+
+	_, err := outbox.Enqueue(testOutboxMessage())
+	require.NoError(t, err)
+	require.NoError(t, outbox.ProcessPending(context.Background()))
+
+	failed, err := outbox.Failed()
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "ErrorAccessDenied", failed[0].ResponseCode)
+}
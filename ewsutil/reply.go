@@ -0,0 +1,103 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// ResponseConfig completes a reply, reply-all or forward
+type ResponseConfig struct {
+	// To, Cc and Bcc are added to the recipients the server derives from the original message
+	To  []string
+	Cc  []string
+	Bcc []string
+	// Attachments are added to the response, which is then created as a draft before being sent
+	Attachments []ews.FileAttachment
+	// SaveAsDraft keeps the response in the drafts folder instead of sending it
+	SaveAsDraft bool
+}
+
+// Reply replies to the sender of a message in the same conversation, with body as HTML above
+// the quoted message. It returns the id of the draft when config.SaveAsDraft is set, nil otherwise.
+func Reply(c ews.Client, itemId ews.ItemId, body string, config ResponseConfig) (*ews.ItemId, error) {
+	return respond(c, ews.ReplyToItem(newSmartResponse(itemId, body, config)), config)
+}
+
+// ReplyAll replies to the sender and all recipients of a message, see Reply
+func ReplyAll(c ews.Client, itemId ews.ItemId, body string, config ResponseConfig) (*ews.ItemId, error) {
+	return respond(c, ews.ReplyAllToItem(newSmartResponse(itemId, body, config)), config)
+}
+
+// Forward forwards a message to the config.To recipients, see Reply
+func Forward(c ews.Client, itemId ews.ItemId, body string, config ResponseConfig) (*ews.ItemId, error) {
+	if len(config.To) == 0 {
+		return nil, errors.New("forward has no recipient")
+	}
+	return respond(c, ews.ForwardItem(newSmartResponse(itemId, body, config)), config)
+}
+
+func newSmartResponse(itemId ews.ItemId, body string, config ResponseConfig) ews.SmartResponse {
+	return ews.SmartResponse{
+		ToRecipients:    newXMailbox(config.To),
+		CcRecipients:    newXMailbox(config.Cc),
+		BccRecipients:   newXMailbox(config.Bcc),
+		ReferenceItemId: &itemId,
+		NewBodyContent: &ews.Body{
+			BodyType: "HTML",
+			Body:     []byte(body),
+		},
+	}
+}
+
+func newXMailbox(addresses []string) *ews.XMailbox {
+	if len(addresses) == 0 {
+		return nil
+	}
+	mailbox := &ews.XMailbox{Mailbox: make([]ews.Mailbox, len(addresses))}
+	for i, addr := range addresses {
+		mailbox.Mailbox[i].EmailAddress = addr
+	}
+	return mailbox
+}
+
+func respond(c ews.Client, response any, config ResponseConfig) (*ews.ItemId, error) {
+	if len(config.Attachments) == 0 && !config.SaveAsDraft {
+		_, err := ews.CreateSmartResponse(c, response, ews.CreateItemRequestConfig{
+			MessageDisposition: ews.MessageDispositionSendAndSaveCopy,
+			SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: &ews.DistinguishedFolderId{Id: ews.DistinguishedFolderIdSentItems}},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to send response")
+		}
+		return nil, nil
+	}
+
+	itemId, err := ews.CreateSmartResponse(c, response, ews.CreateItemRequestConfig{
+		MessageDisposition: ews.MessageDispositionSaveOnly,
+		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: &ews.DistinguishedFolderId{Id: ews.DistinguishedFolderIdDrafts}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create response draft")
+	}
+	if itemId == nil {
+		return nil, errors.New("response draft has no id")
+	}
+
+	if len(config.Attachments) > 0 {
+		_, err := ews.CreateAttachment(c, *itemId, ews.Attachments{FileAttachment: config.Attachments})
+		if err != nil {
+			_ = DeleteDraft(c, itemId)
+			return nil, errors.Wrap(err, "failed to attach files to response")
+		}
+		// the attachments changed the ChangeKey of the draft
+		itemId = &ews.ItemId{Id: itemId.Id}
+	}
+
+	if config.SaveAsDraft {
+		return itemId, nil
+	}
+	if err := sendDraft(c, itemId, ""); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
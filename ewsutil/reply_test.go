@@ -0,0 +1,44 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Reply_withAttachments(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:CreateItemResponse><m:ResponseMessages>
+  <m:CreateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK1" /></t:Message></m:Items>
+  </m:CreateItemResponseMessage>
+</m:ResponseMessages></m:CreateItemResponse>`),
+		soapEnvelope(`<m:CreateAttachmentResponse><m:ResponseMessages>
+  <m:CreateAttachmentResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Attachments><t:FileAttachment><t:AttachmentId Id="AAMkAttachment" /></t:FileAttachment></m:Attachments>
+  </m:CreateAttachmentResponseMessage>
+</m:ResponseMessages></m:CreateAttachmentResponse>`),
+		soapEnvelope(`<m:SendItemResponse><m:ResponseMessages>
+  <m:SendItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:SendItemResponseMessage>
+</m:ResponseMessages></m:SendItemResponse>`),
+	}}
+
+	itemId, err := Reply(c, ews.ItemId{Id: "AAMkMessage"}, "<p>See the log attached</p>", ResponseConfig{
+		Attachments: []ews.FileAttachment{{Name: "log.txt", Content: "aGVsbG8="}},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, itemId)
+
+	require.Len(t, c.requests, 3)
+	assert.Contains(t, c.requests[0], `MessageDisposition="SaveOnly"`)
+	assert.Contains(t, c.requests[0], `<ReplyToItem xmlns="http://schemas.microsoft.com/exchange/services/2006/types">`)
+	assert.Contains(t, c.requests[1], `<ParentItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" Id="AAMkDraft" ChangeKey="CK1">`)
+	assert.Contains(t, c.requests[2], `<ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkDraft"></ItemId>`)
+}
+
+func Test_Forward_requiresRecipient(t *testing.T) {
+	_, err := Forward(&stubClient{}, ews.ItemId{Id: "AAMkMessage"}, "FYI", ResponseConfig{})
+	assert.EqualError(t, err, "forward has no recipient")
+}
@@ -0,0 +1,152 @@
+package ewsutil
+
+import (
+	"time"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// scheduledEmailsPageSize is the number of messages requested per FindItem page
+const scheduledEmailsPageSize = 100
+
+var deferredSendTimeFieldURI = ews.ExtendedFieldURI{
+	PropertyTag:  ews.PropertyTagDeferredSendTime,
+	PropertyType: ews.PropertyTypeSystemTime,
+}
+
+// ScheduledEmail is a sent message waiting in the Outbox for its deferred send time
+type ScheduledEmail struct {
+	ItemId  ews.ItemId
+	Subject string
+	SendAt  time.Time
+}
+
+// ScheduleEmail sends m at sendAt: the message is submitted now and the server holds it
+// in the Outbox until then. It returns the id of the message.
+func ScheduleEmail(c ews.Client, m ews.Message, sendAt time.Time) (*ews.ItemId, error) {
+	return scheduleEmail(c, m, sendAt, "")
+}
+
+func scheduleEmail(c ews.Client, m ews.Message, sendAt time.Time, mailbox string) (*ews.ItemId, error) {
+	if !sendAt.After(time.Now()) {
+		return nil, errors.New("send time is not in the future")
+	}
+
+	n := len(m.ExtendedProperties)
+	m.ExtendedProperties = append(m.ExtendedProperties[:n:n], ews.ExtendedProperty{
+		ExtendedFieldURI: &deferredSendTimeFieldURI,
+		Value:            utils.Ptr(formatSystemTime(sendAt)),
+	})
+
+	itemId, err := sendEmailWithSaveThenSend(c, m, mailbox)
+	if err != nil {
+		return nil, errors.Wrap(sharedMailboxError(mailbox, err), "failed to schedule email")
+	}
+	return itemId, nil
+}
+
+// ListScheduledEmails returns the messages of the Outbox of mailbox waiting for their deferred
+// send time, mailbox is the caller's own when empty
+func ListScheduledEmails(c ews.Client, mailbox string) ([]ScheduledEmail, error) {
+	outboxId := ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdOutbox, mailboxEmail(mailbox))
+
+	var scheduled []ScheduledEmail
+	offset := 0
+	for {
+		findItemResponse, err := ews.FindItemInFolders(c, ews.NewFolderIds(outboxId), ews.FindItemRequestConfig{
+			Traversal: utils.Ptr(ews.FindItemTraversalShallow),
+			BaseShape: utils.Ptr(ews.BaseShapeIdOnly),
+			AdditionalProperties: &ews.AdditionalProperties{
+				FieldURI:         []ews.FieldURI{{FieldURI: "item:Subject"}},
+				ExtendedFieldURI: []ews.ExtendedFieldURI{deferredSendTimeFieldURI},
+			},
+			Restriction: &ews.Restriction{
+				Exists: &ews.Exists{ExtendedFieldURI: &deferredSendTimeFieldURI},
+			},
+			IndexedPageItemView: &ews.IndexedPageItemView{
+				MaxEntriesReturned: scheduledEmailsPageSize,
+				Offset:             offset,
+				BasePoint:          ews.BasePointBeginning,
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrap(sharedMailboxError(mailbox, err), "failed to find scheduled emails")
+		}
+
+		message := findItemResponse.ResponseMessages.FindItemResponseMessage[0]
+		messages := message.RootFolder.Items.Message
+		offset += len(messages)
+		for _, m := range messages {
+			if m.ItemId == nil {
+				continue
+			}
+			email := ScheduledEmail{ItemId: *m.ItemId}
+			if m.Subject != nil {
+				email.Subject = *m.Subject
+			}
+			for _, property := range m.ExtendedProperties {
+				if property.ExtendedFieldURI != nil && property.ExtendedFieldURI.PropertyTag == ews.PropertyTagDeferredSendTime && property.Value != nil {
+					email.SendAt, err = time.Parse(time.RFC3339, *property.Value)
+					if err != nil {
+						return nil, errors.Wrap(err, "failed to parse deferred send time")
+					}
+				}
+			}
+			scheduled = append(scheduled, email)
+		}
+
+		if message.RootFolder.IncludesLastItemInRange || len(messages) == 0 {
+			return scheduled, nil
+		}
+	}
+}
+
+// RescheduleEmail changes the time a scheduled message is sent at
+func RescheduleEmail(c ews.Client, itemId ews.ItemId, sendAt time.Time) error {
+	if !sendAt.After(time.Now()) {
+		return errors.New("send time is not in the future")
+	}
+
+	_, err := ews.NewUpdateItemBuilder().
+		Item(itemId, ews.ItemTypeMessage).
+		SetExtended(deferredSendTimeFieldURI, formatSystemTime(sendAt)).
+		Send(c)
+	if err != nil {
+		return errors.Wrap(err, "failed to reschedule email")
+	}
+	return nil
+}
+
+// CancelScheduledEmail stops a scheduled message from being sent and moves it back to
+// the drafts folder of mailbox, the caller's own when empty, it returns the id of the draft.
+// The message leaves the Outbox before its deferred send time is cleared, which would
+// otherwise let the server send it at once.
+func CancelScheduledEmail(c ews.Client, itemId ews.ItemId, mailbox string) (*ews.ItemId, error) {
+	draftsFolderId := ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdDrafts, mailboxEmail(mailbox))
+	movedItemIds, err := MoveItems(c, []ews.ItemId{{Id: itemId.Id}}, draftsFolderId)
+	if err != nil {
+		return nil, errors.Wrap(sharedMailboxError(mailbox, err), "failed to move scheduled email to drafts")
+	}
+	if movedItemIds[0] == nil {
+		return nil, errors.New("missing draft id in response")
+	}
+	draftId := *movedItemIds[0]
+
+	newItemIds, err := ews.NewUpdateItemBuilder().
+		Item(draftId, ews.ItemTypeMessage).
+		DeleteExtended(deferredSendTimeFieldURI).
+		Send(c)
+	if err != nil {
+		return nil, errors.Wrap(sharedMailboxError(mailbox, err), "failed to cancel scheduled email")
+	}
+	if len(newItemIds) == 1 && newItemIds[0] != nil {
+		draftId = *newItemIds[0]
+	}
+	return &draftId, nil
+}
+
+func formatSystemTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
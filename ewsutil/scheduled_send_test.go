@@ -0,0 +1,107 @@
+package ewsutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScheduleEmail(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:CreateItemResponse><m:ResponseMessages>
+  <m:CreateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK1" /></t:Message></m:Items>
+  </m:CreateItemResponseMessage>
+</m:ResponseMessages></m:CreateItemResponse>`),
+		soapEnvelope(`<m:SendItemResponse><m:ResponseMessages>
+  <m:SendItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:SendItemResponseMessage>
+</m:ResponseMessages></m:SendItemResponse>`),
+	}}
+
+	sendAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err := ScheduleEmail(c, ews.Message{Subject: utils.Ptr("Reminder")}, sendAt)
+	require.NoError(t, err)
+
+	assert.Contains(t, c.requests[0], `<ExtendedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" PropertyTag="0x3fef" PropertyType="SystemTime"></ExtendedFieldURI>
+        <Value xmlns="http://schemas.microsoft.com/exchange/services/2006/types">`+sendAt.UTC().Format(time.RFC3339)+`</Value>`)
+
+	_, err = ScheduleEmail(c, ews.Message{}, time.Now().Add(-time.Minute))
+	assert.EqualError(t, err, "send time is not in the future")
+}
+
+func scheduledEmailsPage(includesLast bool, ids ...string) string {
+	var items string
+	for _, id := range ids {
+		items += `<t:Message>
+        <t:ItemId Id="` + id + `" ChangeKey="CK1" />
+        <t:Subject>Reminder</t:Subject>
+        <t:ExtendedProperty>
+          <t:ExtendedFieldURI PropertyTag="0x3fef" PropertyType="SystemTime" />
+          <t:Value>2025-03-04T09:00:00Z</t:Value>
+        </t:ExtendedProperty>
+      </t:Message>`
+	}
+	includesLastItemInRange := "false"
+	if includesLast {
+		includesLastItemInRange = "true"
+	}
+	return soapEnvelope(`<m:FindItemResponse><m:ResponseMessages>
+  <m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:RootFolder TotalItemsInView="3" IncludesLastItemInRange="` + includesLastItemInRange + `">
+      <t:Items>` + items + `</t:Items>
+    </m:RootFolder>
+  </m:FindItemResponseMessage>
+</m:ResponseMessages></m:FindItemResponse>`)
+}
+
+func Test_ListScheduledEmails(t *testing.T) {
+	c := &stubClient{responses: []string{
+		scheduledEmailsPage(false, "AAMkScheduled1", "AAMkScheduled2"),
+		scheduledEmailsPage(true, "AAMkScheduled3"),
+	}}
+
+	scheduled, err := ListScheduledEmails(c, "support@contoso.com")
+	require.NoError(t, err)
+	require.Len(t, scheduled, 3)
+	assert.Equal(t, ScheduledEmail{
+		ItemId:  ews.ItemId{Id: "AAMkScheduled1", ChangeKey: "CK1"},
+		Subject: "Reminder",
+		SendAt:  time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC),
+	}, scheduled[0])
+	assert.Equal(t, "AAMkScheduled3", scheduled[2].ItemId.Id)
+
+	require.Len(t, c.requests, 2)
+	assert.Contains(t, c.requests[0], `Id="outbox">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@contoso.com</EmailAddress>`)
+	assert.Contains(t, c.requests[0], `Offset="0"`)
+	assert.Contains(t, c.requests[1], `Offset="2"`)
+}
+
+func Test_CancelScheduledEmail(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:MoveItemResponse><m:ResponseMessages>
+  <m:MoveItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK2" /></t:Message></m:Items>
+  </m:MoveItemResponseMessage>
+</m:ResponseMessages></m:MoveItemResponse>`),
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK3" /></t:Message></m:Items>
+  </m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+	}}
+
+	itemId, err := CancelScheduledEmail(c, ews.ItemId{Id: "AAMkScheduled", ChangeKey: "CK1"}, "support@contoso.com")
+	require.NoError(t, err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkDraft", ChangeKey: "CK3"}, itemId)
+	assert.Contains(t, c.requests[0], `Id="drafts">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@contoso.com</EmailAddress>`)
+	assert.Contains(t, c.requests[1], `Id="AAMkDraft" ChangeKey="CK2"`)
+	assert.Contains(t, c.requests[1], `<DeleteItemField`)
+}
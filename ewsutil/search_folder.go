@@ -0,0 +1,146 @@
+package ewsutil
+
+import (
+	"strings"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/pkg/errors"
+)
+
+// CreateSearchFolder creates a search folder under the search folders root of mailbox,
+// the caller's own mailbox when empty, and returns its id
+func CreateSearchFolder(c ews.Client, mailbox, displayName string, params ews.SearchParameters) (*ews.FolderId, error) {
+	createFolderResponse, err := ews.CreateFolder(c,
+		ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdSearchFolders, mailboxEmail(mailbox)),
+		ews.Folders{
+			SearchFolder: []ews.SearchFolder{{
+				Folder: ews.Folder{
+					BaseFolder: ews.BaseFolder{
+						DisplayName: utils.Ptr(displayName),
+					},
+				},
+				SearchParameters: &params,
+			}},
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create search folder")
+	}
+
+	messages := createFolderResponse.ResponseMessages.CreateFolderResponseMessage
+	if len(messages) != 1 {
+		return nil, errors.Errorf("expected 1 created folder, got %d", len(messages))
+	}
+	folders := messages[0].Folders.All()
+	if len(folders) != 1 || folders[0].FolderId == nil {
+		return nil, errors.New("created search folder has no id")
+	}
+
+	return folders[0].FolderId, nil
+}
+
+// FindSearchFolder returns the search folder of mailbox with the given display name
+func FindSearchFolder(c ews.Client, mailbox, displayName string) (*ews.SearchFolder, error) {
+	findFolderResponse, err := ews.FindFolder(c,
+		ews.NewFolderIds(ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdSearchFolders, mailboxEmail(mailbox))),
+		ews.FindFolderRequestConfig{},
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find search folders")
+	}
+
+	for _, message := range findFolderResponse.ResponseMessages.FindFolderResponseMessage {
+		for _, folder := range message.RootFolder.Folders.SearchFolder {
+			if folder.DisplayName != nil && strings.EqualFold(*folder.DisplayName, displayName) {
+				return &folder, nil
+			}
+		}
+	}
+
+	return nil, errors.New("search folder not found: " + displayName)
+}
+
+// UpdateSearchFolder replaces the search parameters of a search folder
+func UpdateSearchFolder(c ews.Client, folderId ews.FolderId, params ews.SearchParameters) error {
+	_, err := ews.UpdateFolder(c, &ews.UpdateFolderRequest{
+		FolderChanges: ews.FolderChanges{
+			FolderChange: []ews.FolderChange{{
+				TargetFolderId: ews.NewTargetFolderId(folderId),
+				Updates: ews.FolderUpdates{
+					SetFolderField: []ews.SetFolderField{{
+						FieldURI:     &ews.FieldURI{FieldURI: "folder:SearchParameters"},
+						SearchFolder: &ews.SearchFolder{SearchParameters: &params},
+					}},
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to update search folder")
+	}
+	return nil
+}
+
+// FindSearchFolderItems returns a page of the items matched by a search folder,
+// RootFolder.IncludesLastItemInRange tells whether more pages follow
+func FindSearchFolderItems(c ews.Client, folderId ews.FolderId, offset, pageSize int) (*ews.RootFolder, error) {
+	findItemResponse, err := ews.FindItemInFolders(c, ews.NewFolderIds(ews.NewTargetFolderId(folderId)), ews.FindItemRequestConfig{
+		Traversal: utils.Ptr(ews.FindItemTraversalShallow),
+		BaseShape: utils.Ptr(ews.BaseShapeDefault),
+		IndexedPageItemView: &ews.IndexedPageItemView{
+			MaxEntriesReturned: pageSize,
+			Offset:             offset,
+			BasePoint:          ews.BasePointBeginning,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find items")
+	}
+
+	return &findItemResponse.ResponseMessages.FindItemResponseMessage[0].RootFolder, nil
+}
+
+// UnreadFromRestriction matches the unread messages sent by any of the given SMTP addresses,
+// or all the unread messages when no address is given
+func UnreadFromRestriction(senders ...string) ews.Restriction {
+	unread := ews.IsEqualTo{
+		FieldURI:           &ews.FieldURI{FieldURI: "message:IsRead"},
+		FieldURIOrConstant: ews.NewConstant("false"),
+	}
+	// an empty Or fails the schema validation
+	if len(senders) == 0 {
+		return ews.Restriction{IsEqualTo: &unread}
+	}
+
+	from := ews.Or{}
+	for _, sender := range senders {
+		from.IsEqualTo = append(from.IsEqualTo, ews.IsEqualTo{
+			ExtendedFieldURI: &ews.ExtendedFieldURI{
+				PropertyTag:  ews.PropertyTagSenderSmtpAddress,
+				PropertyType: ews.PropertyTypeString,
+			},
+			FieldURIOrConstant: ews.NewConstant(sender),
+		})
+	}
+
+	return ews.Restriction{
+		And: &ews.And{SearchExpressions: ews.SearchExpressions{
+			IsEqualTo: []ews.IsEqualTo{unread},
+			Or:        []ews.Or{from},
+		}},
+	}
+}
+
+// CategoryRestriction matches the items tagged with category, among their other categories
+func CategoryRestriction(category string) ews.Restriction {
+	// IsEqualTo on the multi-valued item:Categories only matches the items with a single category
+	return ews.Restriction{
+		Contains: &ews.Contains{
+			ContainmentMode:       ews.ContainmentModeFullString,
+			ContainmentComparison: ews.ContainmentComparisonIgnoreCase,
+			FieldURI:              &ews.FieldURI{FieldURI: "item:Categories"},
+			Constant:              ews.Constant{Value: category},
+		},
+	}
+}
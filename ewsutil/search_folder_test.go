@@ -0,0 +1,41 @@
+package ewsutil
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_CategoryRestriction(t *testing.T) {
+	xmlBytes, err := xml.MarshalIndent(CategoryRestriction("Invoices"), "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<Restriction>
+  <Contains xmlns="http://schemas.microsoft.com/exchange/services/2006/types" ContainmentMode="FullString" ContainmentComparison="IgnoreCase">
+    <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="item:Categories"></FieldURI>
+    <Constant xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Value="Invoices"></Constant>
+  </Contains>
+</Restriction>`, string(xmlBytes))
+}
+
+func Test_marshal_UnreadFromRestriction(t *testing.T) {
+	xmlBytes, err := xml.MarshalIndent(UnreadFromRestriction(), "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<Restriction>
+  <IsEqualTo xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+    <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="message:IsRead"></FieldURI>
+    <FieldURIOrConstant xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <Constant xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Value="false"></Constant>
+    </FieldURIOrConstant>
+  </IsEqualTo>
+</Restriction>`, string(xmlBytes))
+
+	xmlBytes, err = xml.MarshalIndent(UnreadFromRestriction("ceo@contoso.com"), "", "  ")
+	require.NoError(t, err)
+	assert.Contains(t, string(xmlBytes), `<Or xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <IsEqualTo xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <ExtendedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" PropertyTag="0x5d01" PropertyType="String"></ExtendedFieldURI>`)
+}
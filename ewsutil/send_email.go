@@ -28,7 +28,7 @@ func SendEmail(c ews.Client, to []string, subject, body string, attachments ...e
 		}
 	}
 
-	itemId, err := sendEmailWithSaveThenSend(c, m)
+	itemId, err := sendEmailWithSaveThenSend(c, m, "")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to send email")
 	}
@@ -40,28 +40,48 @@ func SendEmail(c ews.Client, to []string, subject, body string, attachments ...e
 // func sendEmailWithAtomicSaveAndSend(c ews.Client, m ews.Message) (*ews.ItemId, error) {
 // 	return ews.CreateMessageItem(c, m, ews.CreateItemRequestConfig{
 // 		MessageDisposition: ews.MessageDispositionSendAndSaveCopy,
-// 		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: ews.DistinguishedFolderId{Id: "sentitems"}},
+// 		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: &ews.DistinguishedFolderId{Id: "sentitems"}},
 // 	})
 // }
 
-func sendEmailWithSaveThenSend(c ews.Client, m ews.Message) (*ews.ItemId, error) {
+// sendEmailWithSaveThenSend saves m in the drafts of mailbox, then sends it keeping the sent copy
+// in the sent items of mailbox, the caller's own mailbox when empty
+func sendEmailWithSaveThenSend(c ews.Client, m ews.Message, mailbox string) (*ews.ItemId, error) {
 	// Save the email draft first
+	draftsFolderId := ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdDrafts, mailboxEmail(mailbox))
 	itemId, err := ews.CreateMessageItem(c, m, ews.CreateItemRequestConfig{
 		MessageDisposition: ews.MessageDispositionSaveOnly,
-		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: ews.DistinguishedFolderId{Id: "drafts"}},
+		SavedItemFolderId:  &draftsFolderId,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create message item")
 	}
 
-	// Send the email draft
-	if err := SendEmailWithItemId(c, itemId); err != nil {
-		return nil, errors.Wrap(err, "failed to send email")
+	if err := sendDraft(c, itemId, mailbox); err != nil {
+		return nil, err
 	}
 
 	return itemId, nil
 }
 
+// sendDraft sends a draft, removing it when the server rejected the send so it does not linger in drafts
+func sendDraft(c ews.Client, itemId *ews.ItemId, mailbox string) error {
+	var err error
+	if mailbox == "" {
+		err = SendEmailWithItemId(c, itemId)
+	} else {
+		_, err = ews.SendItemAndSaveTo(c, *itemId, ews.NewDistinguishedTargetFolderId(ews.DistinguishedFolderIdSentItems, mailboxEmail(mailbox)))
+	}
+	if err != nil {
+		var responseError *ews.ResponseError
+		if errors.As(err, &responseError) {
+			_ = DeleteDraft(c, itemId)
+		}
+		return errors.Wrap(err, "failed to send email")
+	}
+	return nil
+}
+
 func SendEmailWithItemId(c ews.Client, itemId *ews.ItemId) error {
 	_, err := ews.SendItem(c, *itemId, true)
 	if err != nil {
@@ -0,0 +1,77 @@
+package ewsutil
+
+import (
+	"bytes"
+	"io"
+	"net/mail"
+	"sort"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// SaveMIME saves a MIME message read from r in the drafts folder and returns its id
+func SaveMIME(c ews.Client, r io.Reader) (*ews.ItemId, error) {
+	mimeContent, err := ews.ReadMimeContent(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read mime message")
+	}
+
+	itemId, err := ews.CreateMimeMessage(c, mimeContent, ews.CreateItemRequestConfig{
+		MessageDisposition: ews.MessageDispositionSaveOnly,
+		SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: &ews.DistinguishedFolderId{Id: ews.DistinguishedFolderIdDrafts}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save mime message")
+	}
+	if itemId == nil {
+		return nil, errors.New("saved mime message has no id")
+	}
+	return itemId, nil
+}
+
+// SendMIME sends a MIME message read from r as is, ex: a multipart, S/MIME signed or calendar
+// invite message built with other tooling. saveCopy keeps a copy in the sent items folder.
+func SendMIME(c ews.Client, r io.Reader, saveCopy bool) error {
+	mimeContent, err := ews.ReadMimeContent(r)
+	if err != nil {
+		return errors.Wrap(err, "failed to read mime message")
+	}
+
+	config := ews.CreateItemRequestConfig{MessageDisposition: ews.MessageDispositionSendOnly}
+	if saveCopy {
+		config = ews.CreateItemRequestConfig{
+			MessageDisposition: ews.MessageDispositionSendAndSaveCopy,
+			SavedItemFolderId:  &ews.SavedItemFolderId{DistinguishedFolderId: &ews.DistinguishedFolderId{Id: ews.DistinguishedFolderIdSentItems}},
+		}
+	}
+
+	if _, err := ews.CreateMimeMessage(c, mimeContent, config); err != nil {
+		return errors.Wrap(err, "failed to send mime message")
+	}
+	return nil
+}
+
+// SendMailMessage sends a net/mail message, its headers are written in sorted order
+// followed by its body, see SendMIME
+func SendMailMessage(c ews.Client, m *mail.Message, saveCopy bool) error {
+	var buf bytes.Buffer
+	names := make([]string, 0, len(m.Header))
+	for name := range m.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range m.Header[name] {
+			buf.WriteString(name + ": " + value + "\r\n")
+		}
+	}
+	buf.WriteString("\r\n")
+	if m.Body != nil {
+		if _, err := io.Copy(&buf, m.Body); err != nil {
+			return errors.Wrap(err, "failed to read message body")
+		}
+	}
+
+	return SendMIME(c, &buf, saveCopy)
+}
@@ -0,0 +1,51 @@
+package ewsutil
+
+import (
+	"github.com/hoshii-ai/ews"
+	"github.com/pkg/errors"
+)
+
+// SendEmailAs sends an email as a shared mailbox, see MessageBuilder.SendAs
+func SendEmailAs(c ews.Client, from string, to []string, subject, body string, attachments ...ews.FileAttachment) (*ews.ItemId, error) {
+	return NewMessageBuilder().
+		SendAs(from).
+		To(to...).
+		Subject(subject).
+		HTMLBody(body).
+		Attach(attachments...).
+		Send(c)
+}
+
+// sharedMailboxError explains the errors caused by missing permissions on a shared mailbox,
+// the response error stays available to ews.IsResponseCode
+func sharedMailboxError(mailbox string, err error) error {
+	if mailbox == "" {
+		return err
+	}
+
+	switch {
+	case ews.IsResponseCode(err, "ErrorSendAsDenied"):
+		return errors.Wrapf(err, "missing Send As permission on %s", mailbox)
+	case ews.IsResponseCode(err, "ErrorAccessDenied"), ews.IsResponseCode(err, "ErrorItemNotFound"):
+		return errors.Wrapf(err, "missing full access or folder permissions on the drafts and sent items folders of %s", mailbox)
+	case ews.IsResponseCode(err, "ErrorNonExistentMailbox"):
+		return errors.Wrapf(err, "mailbox %s does not exist", mailbox)
+	}
+	return err
+}
+
+// onBehalfOfError explains the errors of a message sent on behalf of mailbox, whose draft and
+// sent copy are in the caller's own folders
+func onBehalfOfError(mailbox string, err error) error {
+	if mailbox == "" {
+		return err
+	}
+
+	switch {
+	case ews.IsResponseCode(err, "ErrorSendAsDenied"):
+		return errors.Wrapf(err, "missing Send on Behalf permission on %s", mailbox)
+	case ews.IsResponseCode(err, "ErrorNonExistentMailbox"):
+		return errors.Wrapf(err, "mailbox %s does not exist", mailbox)
+	}
+	return err
+}
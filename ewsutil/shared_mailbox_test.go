@@ -0,0 +1,116 @@
+package ewsutil
+
+import (
+	"testing"
+
+	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	createDraftResponse = `<m:CreateItemResponse><m:ResponseMessages>
+  <m:CreateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK1" /></t:Message></m:Items>
+  </m:CreateItemResponseMessage>
+</m:ResponseMessages></m:CreateItemResponse>`
+	sendItemResponse = `<m:SendItemResponse><m:ResponseMessages>
+  <m:SendItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:SendItemResponseMessage>
+</m:ResponseMessages></m:SendItemResponse>`
+)
+
+func Test_SendEmailAs(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:CreateItemResponse><m:ResponseMessages>
+  <m:CreateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK1" /></t:Message></m:Items>
+  </m:CreateItemResponseMessage>
+</m:ResponseMessages></m:CreateItemResponse>`),
+		soapEnvelope(`<m:SendItemResponse><m:ResponseMessages>
+  <m:SendItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:SendItemResponseMessage>
+</m:ResponseMessages></m:SendItemResponse>`),
+	}}
+
+	_, err := SendEmailAs(c, "Support <support@contoso.com>", []string{"jane@contoso.com"}, "Ticket #42", "<p>Fixed</p>")
+	require.NoError(t, err)
+
+	require.Len(t, c.requests, 2)
+	assert.Contains(t, c.requests[0], `<DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="drafts">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@contoso.com</EmailAddress>`)
+	assert.Contains(t, c.requests[0], `<From xmlns="http://schemas.microsoft.com/exchange/services/2006/types">`)
+	assert.NotContains(t, c.requests[0], `<Attachments`)
+	assert.Contains(t, c.requests[1], `<DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="sentitems">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@contoso.com</EmailAddress>`)
+}
+
+func Test_MessageBuilder_SendOnBehalfOf(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(createDraftResponse),
+		soapEnvelope(sendItemResponse),
+	}}
+
+	_, err := NewMessageBuilder().
+		SendOnBehalfOf("Support <support@contoso.com>").
+		To("jane@contoso.com").
+		Subject("Ticket #42").
+		Send(c)
+	require.NoError(t, err)
+
+	require.Len(t, c.requests, 2)
+	assert.Contains(t, c.requests[0], `<From xmlns="http://schemas.microsoft.com/exchange/services/2006/types">`)
+	assert.NotContains(t, c.requests[0], `<Sender`)
+	assert.Contains(t, c.requests[0], `<DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="drafts"></DistinguishedFolderId>`)
+	assert.NotContains(t, c.requests[1], `<SavedItemFolderId`)
+}
+
+func Test_SendEmailAs_denied(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(`<m:CreateItemResponse><m:ResponseMessages>
+  <m:CreateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkDraft" ChangeKey="CK1" /></t:Message></m:Items>
+  </m:CreateItemResponseMessage>
+</m:ResponseMessages></m:CreateItemResponse>`),
+		soapEnvelope(`<m:SendItemResponse><m:ResponseMessages>
+  <m:SendItemResponseMessage ResponseClass="Error">
+    <m:MessageText>The user account which was used to submit this request does not have the right to send mail on behalf of the specified sending account.</m:MessageText>
+    <m:ResponseCode>ErrorSendAsDenied</m:ResponseCode>
+  </m:SendItemResponseMessage>
+</m:ResponseMessages></m:SendItemResponse>`),
+		soapEnvelope(`<m:DeleteItemResponse><m:ResponseMessages>
+  <m:DeleteItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:DeleteItemResponseMessage>
+</m:ResponseMessages></m:DeleteItemResponse>`),
+	}}
+
+	_, err := SendEmailAs(c, "support@contoso.com", []string{"jane@contoso.com"}, "Ticket #42", "<p>Fixed</p>")
+	require.Error(t, err)
+	assert.True(t, ews.IsResponseCode(err, "ErrorSendAsDenied"))
+	assert.Contains(t, err.Error(), "missing Send As permission on support@contoso.com")
+	assert.Len(t, c.requests, 3, "the draft is deleted")
+}
+
+func Test_MessageBuilder_SendOnBehalfOf_denied(t *testing.T) {
+	c := &stubClient{responses: []string{
+		soapEnvelope(createDraftResponse),
+		soapEnvelope(`<m:SendItemResponse><m:ResponseMessages>
+  <m:SendItemResponseMessage ResponseClass="Error">
+    <m:MessageText>The user account which was used to submit this request does not have the right to send mail on behalf of the specified sending account.</m:MessageText>
+    <m:ResponseCode>ErrorSendAsDenied</m:ResponseCode>
+  </m:SendItemResponseMessage>
+</m:ResponseMessages></m:SendItemResponse>`),
+		soapEnvelope(`<m:DeleteItemResponse><m:ResponseMessages>
+  <m:DeleteItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode></m:DeleteItemResponseMessage>
+</m:ResponseMessages></m:DeleteItemResponse>`),
+	}}
+
+	_, err := NewMessageBuilder().
+		SendOnBehalfOf("support@contoso.com").
+		To("jane@contoso.com").
+		Subject("Ticket #42").
+		Send(c)
+	require.Error(t, err)
+	assert.True(t, ews.IsResponseCode(err, "ErrorSendAsDenied"))
+	assert.Contains(t, err.Error(), "missing Send on Behalf permission on support@contoso.com")
+	assert.Len(t, c.requests, 3, "the draft is deleted")
+}
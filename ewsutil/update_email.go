@@ -2,47 +2,78 @@ package ewsutil
 
 import (
 	"github.com/hoshii-ai/ews"
-	"github.com/hoshii-ai/ews/utils"
 	"github.com/pkg/errors"
 )
 
-// UpdateEmailCategories updates the categories of an email by overwriting the existing categories.
+// UpdateEmailCategories sets the categories of an email. When itemId carries the ChangeKey of the
+// email the caller read, the update fails with the conflict if another writer changed the email since.
+// Without a ChangeKey, the categories added and removed are computed against the categories of the
+// email when the update starts, and merged into the latest version of the email when a concurrent
+// write changed it, so that the categories set by the other writer are kept. The returned item id
+// carries the new ChangeKey.
 func UpdateEmailCategories(c ews.Client, itemId *ews.ItemId, categories []string) (*ews.ItemId, error) {
-	categories_ := ews.Categories{
-		String: categories,
-	}
-	updateItemRequest := ews.UpdateItemRequest{
-		MessageDisposition: ews.MessageDispositionSaveOnly,
-		ConflictResolution: utils.Ptr(ews.ConflictResolutionAlwaysOverwrite),
-		ItemChanges: ews.ItemChanges{
-			ItemChange: []ews.ItemChange{
-				{
-					ItemId: *itemId,
-					Updates: ews.Updates{
-						SetItemField: []ews.SetItemField{
-							{
-								FieldURI: &ews.FieldURI{
-									FieldURI: "item:Categories",
-								},
-								Message: &ews.Message{
-									Categories: &categories_,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+	config := ModifyItemConfig{ItemShape: categoriesItemShape()}
+	if itemId.ChangeKey != "" {
+		// the categories the caller read are unknown, merging would drop the changes of the other writer
+		config.MaxAttempts = 1
 	}
 
-	updateItemResponse, err := ews.UpdateItem(c, &updateItemRequest)
+	var added, removed []string
+	var diffed bool
+
+	newItemId, err := ModifyItem(c, *itemId, config, func(items ews.Items, b *ews.UpdateItemBuilder) error {
+		if len(items.Message) != 1 {
+			return errors.Errorf("expected 1 message, got %d", len(items.Message))
+		}
+		var current []string
+		if items.Message[0].Categories != nil {
+			current = items.Message[0].Categories.String
+		}
+
+		if !diffed {
+			added, removed = difference(categories, current), difference(current, categories)
+			diffed = true
+		}
+
+		merged := append(difference(current, removed), difference(added, current)...)
+		if len(difference(merged, current)) == 0 && len(difference(current, merged)) == 0 {
+			return SkipUpdate
+		}
+		if len(merged) == 0 {
+			b.Delete("item:Categories")
+			return nil
+		}
+		b.Set("item:Categories", &ews.Categories{String: merged})
+		return nil
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update item")
 	}
 
-	if ews.ResponseClass(updateItemResponse.ResponseMessages.UpdateItemResponseMessage.ResponseClass) != ews.ResponseClassSuccess {
-		return nil, errors.New("failed to update item: " + updateItemResponse.ResponseMessages.UpdateItemResponseMessage.ResponseCode)
+	return newItemId, nil
+}
+
+func categoriesItemShape() *ews.ItemShape {
+	return &ews.ItemShape{
+		BaseShape: ews.BaseShapeIdOnly,
+		AdditionalProperties: &ews.AdditionalProperties{
+			FieldURI: []ews.FieldURI{{FieldURI: "item:Categories"}},
+		},
+	}
+}
+
+// difference returns the values of a missing from b, in the order of a
+func difference(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, value := range b {
+		in[value] = true
 	}
 
-	return itemId, nil
+	var values []string
+	for _, value := range a {
+		if !in[value] {
+			values = append(values, value)
+		}
+	}
+	return values
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/hoshii-ai/ews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_UpdateEmailCategories(t *testing.T) {
@@ -44,3 +46,56 @@ func Test_UpdateEmailCategories(t *testing.T) {
 	t.Logf("updated email: %+v", *email.Subject)
 	t.Logf("updated email categories: %s", missingCategory)
 }
+
+func Test_UpdateEmailCategories_mergesConcurrentChanges(t *testing.T) {
+	getItemResponse := func(changeKey string, categories ...string) string {
+		var strings string
+		for _, category := range categories {
+			strings += `<t:String>` + category + `</t:String>`
+		}
+		return soapEnvelope(`<m:GetItemResponse><m:ResponseMessages>
+  <m:GetItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkMessage" ChangeKey="` + changeKey + `" /><t:Categories>` + strings + `</t:Categories></t:Message></m:Items>
+  </m:GetItemResponseMessage>
+</m:ResponseMessages></m:GetItemResponse>`)
+	}
+	c := &stubClient{responses: []string{
+		getItemResponse("CK1", "Red", "Blue"),
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorIrresolvableConflict</m:ResponseCode></m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+		// another writer added Green meanwhile
+		getItemResponse("CK2", "Red", "Blue", "Green"),
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+    <m:Items><t:Message><t:ItemId Id="AAMkMessage" ChangeKey="CK3" /></t:Message></m:Items>
+  </m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+	}}
+
+	itemId, err := UpdateEmailCategories(c, &ews.ItemId{Id: "AAMkMessage"}, []string{"Red", "Yellow"})
+	require.NoError(t, err)
+	assert.Equal(t, &ews.ItemId{Id: "AAMkMessage", ChangeKey: "CK3"}, itemId)
+
+	assert.Contains(t, c.requests[0], `FieldURI="item:Categories"`)
+	assert.Contains(t, c.requests[1], `<String xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Red</String>
+              <String xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Yellow</String>`)
+	assert.Contains(t, c.requests[3], `ChangeKey="CK2"`)
+	assert.Contains(t, c.requests[3], `<String xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Red</String>
+              <String xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Green</String>
+              <String xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Yellow</String>`)
+}
+
+func Test_UpdateEmailCategories_returnsConflict(t *testing.T) {
+	c := &stubClient{responses: []string{
+		getItemResponse("CK2"),
+		soapEnvelope(`<m:UpdateItemResponse><m:ResponseMessages>
+  <m:UpdateItemResponseMessage ResponseClass="Error"><m:ResponseCode>ErrorIrresolvableConflict</m:ResponseCode></m:UpdateItemResponseMessage>
+</m:ResponseMessages></m:UpdateItemResponse>`),
+	}}
+
+	_, err := UpdateEmailCategories(c, &ews.ItemId{Id: "AAMkMessage", ChangeKey: "CK1"}, []string{"Red"})
+	assert.True(t, ews.IsResponseCode(err, "ErrorIrresolvableConflict"))
+	require.Len(t, c.requests, 2)
+	assert.Contains(t, c.requests[1], `ChangeKey="CK1"`)
+}
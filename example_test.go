@@ -231,7 +231,7 @@ func testFindPeople(c Client) error {
 		Offset:             0,
 		BasePoint:          BasePointBeginning,
 	}, ParentFolderId: ParentFolderId{
-		DistinguishedFolderId: DistinguishedFolderId{Id: "directory"}},
+		DistinguishedFolderId: &DistinguishedFolderId{Id: "directory"}},
 		PersonaShape: &PersonaShape{BaseShape: BaseShapeIdOnly,
 			AdditionalProperties: AdditionalProperties{
 				FieldURI: []FieldURI{
@@ -2,6 +2,7 @@ package ews
 
 import (
 	"encoding/xml"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -36,6 +37,27 @@ func (s HTTPError) Error() string {
 	return s.Status
 }
 
+// ResponseError is returned when a response message reports ResponseClass Error,
+// ex: ErrorItemNotFound, ErrorAccessDenied
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/responsecode
+type ResponseError struct {
+	ResponseCode string
+	MessageText  string
+}
+
+func (e *ResponseError) Error() string {
+	if e.MessageText == "" {
+		return e.ResponseCode
+	}
+	return e.ResponseCode + ": " + e.MessageText
+}
+
+// IsResponseCode reports whether err is, or wraps, a ResponseError with the given code
+func IsResponseCode(err error, responseCode string) bool {
+	var responseError *ResponseError
+	return errors.As(err, &responseError) && responseError.ResponseCode == responseCode
+}
+
 type envelop struct {
 	XMLName struct{} `xml:"Envelope"`
 	Body    body     `xml:"Body"`
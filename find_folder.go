@@ -0,0 +1,103 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type FolderTraversal string
+
+const (
+	FolderTraversalShallow     FolderTraversal = "Shallow"
+	FolderTraversalDeep        FolderTraversal = "Deep"
+	FolderTraversalSoftDeleted FolderTraversal = "SoftDeleted"
+)
+
+type FindFolderRequest struct {
+	XMLName               struct{}             `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindFolder"`
+	Traversal             FolderTraversal      `xml:"Traversal,attr"`
+	FolderShape           FolderShape          `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderShape"`
+	IndexedPageFolderView *IndexedPageItemView `xml:"http://schemas.microsoft.com/exchange/services/2006/messages IndexedPageFolderView,omitempty"`
+	Restriction           *Restriction         `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Restriction,omitempty"`
+	ParentFolderIds       FolderIds            `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ParentFolderIds"`
+}
+
+type FindFolderRequestConfig struct {
+	Traversal             *FolderTraversal
+	FolderShape           *FolderShape
+	IndexedPageFolderView *IndexedPageItemView
+	Restriction           *Restriction
+}
+
+func NewFindFolderRequest(parentFolderIds FolderIds, config FindFolderRequestConfig) *FindFolderRequest {
+	traversal := FolderTraversalShallow
+	if config.Traversal != nil {
+		traversal = *config.Traversal
+	}
+
+	folderShape := FolderShape{
+		BaseShape: BaseShapeAllProperties,
+	}
+	if config.FolderShape != nil {
+		folderShape = *config.FolderShape
+	}
+
+	return &FindFolderRequest{
+		Traversal:             traversal,
+		FolderShape:           folderShape,
+		IndexedPageFolderView: config.IndexedPageFolderView,
+		Restriction:           config.Restriction,
+		ParentFolderIds:       parentFolderIds,
+	}
+}
+
+type findFolderResponseEnvelope struct {
+	XMLName xml.Name               `xml:"Envelope"`
+	Body    findFolderResponseBody `xml:"Body"`
+}
+
+type findFolderResponseBody struct {
+	FindFolderResponse FindFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindFolderResponse"`
+}
+
+type FindFolderResponse struct {
+	ResponseMessages FindFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type FindFolderResponseMessages struct {
+	FindFolderResponseMessage []FindFolderResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindFolderResponseMessage"`
+}
+
+// FindFolderResponseMessage is returned once per parent folder
+type FindFolderResponseMessage struct {
+	Response
+	RootFolder RootFolder `xml:"http://schemas.microsoft.com/exchange/services/2006/messages RootFolder"`
+}
+
+// FindFolder searches the sub folders of the given parent folders, the whole
+// hierarchy below them with FolderTraversalDeep
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/findfolder-operation
+func FindFolder(c Client, parentFolderIds FolderIds, config FindFolderRequestConfig) (*FindFolderResponse, error) {
+	xmlBytes, err := xml.MarshalIndent(NewFindFolderRequest(parentFolderIds, config), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp findFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.FindFolderResponse.ResponseMessages.FindFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.FindFolderResponse, nil
+}
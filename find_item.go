@@ -2,6 +2,7 @@ package ews
 
 import (
 	"encoding/xml"
+	"fmt"
 
 	"github.com/hoshii-ai/ews/utils"
 )
@@ -9,34 +10,33 @@ import (
 // --- Request ---
 
 type FindItemRequest struct {
-	XMLName         struct{}      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindItem"`
-	Traversal       string        `xml:"Traversal,attr,omitempty"`
-	ItemShape       ItemShape     `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemShape"`
-	Restriction     *Restriction  `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Restriction,omitempty"`
-	ParentFolderIds ParentFolders `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ParentFolderIds"`
-	QueryString     *string       `xml:"http://schemas.microsoft.com/exchange/services/2006/messages QueryString,omitempty"`
+	XMLName             struct{}             `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindItem"`
+	Traversal           string               `xml:"Traversal,attr,omitempty"`
+	ItemShape           ItemShape            `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemShape"`
+	IndexedPageItemView *IndexedPageItemView `xml:"http://schemas.microsoft.com/exchange/services/2006/messages IndexedPageItemView,omitempty"`
+	Restriction         *Restriction         `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Restriction,omitempty"`
+	SortOrder           *SortOrder           `xml:"http://schemas.microsoft.com/exchange/services/2006/messages SortOrder,omitempty"`
+	ParentFolderIds     FolderIds            `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ParentFolderIds"`
+	QueryString         *string              `xml:"http://schemas.microsoft.com/exchange/services/2006/messages QueryString,omitempty"`
 }
 
-type Restriction struct {
-	IsEqualTo *IsEqualTo `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsEqualTo,omitempty"`
+// SortOrder sorts the items found by the first FieldOrder, then the next ones
+type SortOrder struct {
+	FieldOrder []FieldOrder `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldOrder"`
 }
 
-type IsEqualTo struct {
-	FieldURI           *FieldURI           `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
-	ExtendedFieldURI   *ExtendedFieldURI   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
-	FieldURIOrConstant *FieldURIOrConstant `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURIOrConstant"`
+type FieldOrder struct {
+	Order            SortDirection     `xml:"Order,attr"`
+	FieldURI         *FieldURI         `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
 }
 
-type FieldURIOrConstant struct {
-	Constant *Constant `xml:"http://schemas.microsoft.com/exchange/services/2006/types Constant,omitempty"`
-}
+type SortDirection string
 
-type Constant struct {
-	Value string `xml:"Value,attr"`
-}
-type ParentFolders struct {
-	FolderIds []DistinguishedFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/types DistinguishedFolderId"`
-}
+const (
+	SortDirectionAscending  SortDirection = "Ascending"
+	SortDirectionDescending SortDirection = "Descending"
+)
 
 // --- Response ---
 
@@ -55,20 +55,31 @@ type FindItemResponse struct {
 	ResponseMessages FindItemResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
 }
 
+// FindItemResponseMessages holds one response message per parent folder, in the order of the request
 type FindItemResponseMessages struct {
-	FindItemResponseMessage FindItemResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindItemResponseMessage"`
+	FindItemResponseMessage []FindItemResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FindItemResponseMessage"`
 }
 
 type FindItemResponseMessage struct {
-	ResponseClass ResponseClass `xml:"ResponseClass,attr"`
-	ResponseCode  string        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseCode"`
-	RootFolder    RootFolder    `xml:"http://schemas.microsoft.com/exchange/services/2006/messages RootFolder"`
+	Response
+	RootFolder RootFolder `xml:"http://schemas.microsoft.com/exchange/services/2006/messages RootFolder"`
+}
+
+// RootFolders returns the items found in each parent folder, in the order of the request
+func (r *FindItemResponse) RootFolders() []RootFolder {
+	rootFolders := make([]RootFolder, len(r.ResponseMessages.FindItemResponseMessage))
+	for i, message := range r.ResponseMessages.FindItemResponseMessage {
+		rootFolders[i] = message.RootFolder
+	}
+	return rootFolders
 }
 
 type RootFolder struct {
-	TotalItemsInView        int   `xml:"TotalItemsInView,attr"`
-	IncludesLastItemInRange bool  `xml:"IncludesLastItemInRange,attr"`
-	Items                   Items `xml:"http://schemas.microsoft.com/exchange/services/2006/types Items"`
+	TotalItemsInView        int     `xml:"TotalItemsInView,attr"`
+	IncludesLastItemInRange bool    `xml:"IncludesLastItemInRange,attr"`
+	IndexedPagingOffset     int     `xml:"IndexedPagingOffset,attr"`
+	Items                   Items   `xml:"http://schemas.microsoft.com/exchange/services/2006/types Items"`
+	Folders                 Folders `xml:"http://schemas.microsoft.com/exchange/services/2006/types Folders"` // FindFolder only
 }
 
 // -- Config --
@@ -86,10 +97,12 @@ type FindItemRequestConfig struct {
 	Query                *string
 	AdditionalProperties *AdditionalProperties
 	Restriction          *Restriction
+	SortOrder            *SortOrder
+	IndexedPageItemView  *IndexedPageItemView
 }
 
 // --- Helper function to create a FindItem request ---
-func NewFindItemRequest(distinguishedFolderId DistinguishedFolderId, config FindItemRequestConfig) *FindItemRequest {
+func NewFindItemRequest(parentFolderIds FolderIds, config FindItemRequestConfig) *FindItemRequest {
 	traversal := FindItemTraversalAssociated
 	if config.Traversal != nil {
 		traversal = *config.Traversal
@@ -111,11 +124,9 @@ func NewFindItemRequest(distinguishedFolderId DistinguishedFolderId, config Find
 			BaseShape:            baseShape,
 			AdditionalProperties: additionalProperties,
 		},
-		ParentFolderIds: ParentFolders{
-			FolderIds: []DistinguishedFolderId{
-				distinguishedFolderId,
-			},
-		},
+		IndexedPageItemView: config.IndexedPageItemView,
+		SortOrder:           config.SortOrder,
+		ParentFolderIds:     parentFolderIds,
 	}
 
 	if config.Restriction != nil {
@@ -129,9 +140,17 @@ func NewFindItemRequest(distinguishedFolderId DistinguishedFolderId, config Find
 	return req
 }
 
-// --- Example function to send request ---
+// FindItem searches the distinguished folder folderId of the caller's own mailbox.
+// Use FindItemInFolders to search other folders, other mailboxes or several folders at once.
 func FindItem(c Client, folderId string, config FindItemRequestConfig) (*FindItemResponse, error) {
-	req := NewFindItemRequest(NewDistinguishedFolderId(folderId, utils.Ptr(c.GetUsername())), config)
+	return FindItemInFolders(c, NewFolderIds(NewDistinguishedTargetFolderId(folderId, utils.Ptr(c.GetUsername()))), config)
+}
+
+// FindItemInFolders searches the items of the given parent folders and returns one response
+// message per folder, or the error of the first folder that failed.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/finditem-operation
+func FindItemInFolders(c Client, parentFolderIds FolderIds, config FindItemRequestConfig) (*FindItemResponse, error) {
+	req := NewFindItemRequest(parentFolderIds, config)
 	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
 	if err != nil {
 		return nil, err
@@ -149,5 +168,25 @@ func FindItem(c Client, folderId string, config FindItemRequestConfig) (*FindIte
 		return nil, err
 	}
 
-	return &soapResp.Body.FindItemResponse, nil
+	return checkFindItemResponse(&soapResp.Body.FindItemResponse, parentFolderIds)
+}
+
+// checkFindItemResponse returns the error of the first failed folder, or of a response missing folders
+func checkFindItemResponse(resp *FindItemResponse, parentFolderIds FolderIds) (*FindItemResponse, error) {
+	messages := resp.ResponseMessages.FindItemResponseMessage
+	folderCount := len(parentFolderIds)
+	if len(messages) != folderCount {
+		return nil, fmt.Errorf("expected %d response messages, got %d", folderCount, len(messages))
+	}
+
+	for i, message := range messages {
+		if err := message.Err(); err != nil {
+			if folderCount == 1 {
+				return nil, err
+			}
+			return nil, fmt.Errorf("parent folder %d: %w", i, err)
+		}
+	}
+
+	return resp, nil
 }
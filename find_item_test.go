@@ -1,8 +1,13 @@
 package ews
 
 import (
+	"encoding/xml"
 	"fmt"
 	"testing"
+
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFindItem(t *testing.T) {
@@ -25,3 +30,80 @@ func TestFindItem(t *testing.T) {
 		// fmt.Println(item.RootFolder.TotalItemsInView)
 	})
 }
+
+func Test_marshal_FindItemRequest_ParentFolderIds(t *testing.T) {
+	parentFolderIds := NewFolderIds(
+		NewDistinguishedTargetFolderId(DistinguishedFolderIdInbox, utils.Ptr("support@example.com")),
+		NewTargetFolderId(FolderId{Id: "AAMkAD", ChangeKey: "AQAAAB"}),
+		NewDistinguishedTargetFolderId(DistinguishedFolderIdDrafts, nil),
+	)
+	req := NewFindItemRequest(parentFolderIds, FindItemRequestConfig{
+		Traversal: utils.Ptr(FindItemTraversalShallow),
+		BaseShape: utils.Ptr(BaseShapeIdOnly),
+	})
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, `<FindItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" Traversal="Shallow">
+  <ItemShape xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <BaseShape xmlns="http://schemas.microsoft.com/exchange/services/2006/types">IdOnly</BaseShape>
+    <AdditionalProperties xmlns="http://schemas.microsoft.com/exchange/services/2006/types"></AdditionalProperties>
+  </ItemShape>
+  <ParentFolderIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="inbox">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@example.com</EmailAddress>
+      </Mailbox>
+    </DistinguishedFolderId>
+    <FolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkAD" ChangeKey="AQAAAB"></FolderId>
+    <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="drafts"></DistinguishedFolderId>
+  </ParentFolderIds>
+</FindItem>`, string(xmlBytes))
+
+	var decoded FindItemRequest
+	require.NoError(t, xml.Unmarshal(xmlBytes, &decoded))
+	assert.Equal(t, parentFolderIds, decoded.ParentFolderIds)
+}
+
+func Test_unmarshal_FindItemResponse_multipleFolders(t *testing.T) {
+	parentFolderIds := NewFolderIds(
+		NewDistinguishedTargetFolderId(DistinguishedFolderIdInbox, utils.Ptr("support@example.com")),
+		NewDistinguishedTargetFolderId(DistinguishedFolderIdInbox, utils.Ptr("sales@example.com")),
+	)
+	unmarshal := func(firstMessage string) *FindItemResponse {
+		var soapResp findItemResponseEnvelope
+		require.NoError(t, xml.Unmarshal([]byte(`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <s:Body><m:FindItemResponse><m:ResponseMessages>`+firstMessage+`
+    <m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+      <m:RootFolder TotalItemsInView="1" IncludesLastItemInRange="true"><t:Items><t:Message><t:ItemId Id="AAMkSales" /></t:Message></t:Items></m:RootFolder>
+    </m:FindItemResponseMessage>
+  </m:ResponseMessages></m:FindItemResponse></s:Body>
+</s:Envelope>`), &soapResp))
+		return &soapResp.Body.FindItemResponse
+	}
+
+	resp, err := checkFindItemResponse(unmarshal(`
+    <m:FindItemResponseMessage ResponseClass="Success"><m:ResponseCode>NoError</m:ResponseCode>
+      <m:RootFolder TotalItemsInView="1" IncludesLastItemInRange="true"><t:Items><t:Message><t:ItemId Id="AAMkSupport" /></t:Message></t:Items></m:RootFolder>
+    </m:FindItemResponseMessage>`), parentFolderIds)
+	require.NoError(t, err)
+	rootFolders := resp.RootFolders()
+	require.Len(t, rootFolders, 2)
+	assert.Equal(t, &ItemId{Id: "AAMkSupport"}, rootFolders[0].Items.Message[0].ItemId)
+	assert.Equal(t, &ItemId{Id: "AAMkSales"}, rootFolders[1].Items.Message[0].ItemId)
+
+	_, err = checkFindItemResponse(unmarshal(`
+    <m:FindItemResponseMessage ResponseClass="Error">
+      <m:MessageText>The specified object was not found in the store.</m:MessageText>
+      <m:ResponseCode>ErrorAccessDenied</m:ResponseCode>
+    </m:FindItemResponseMessage>`), parentFolderIds)
+	require.Error(t, err)
+	assert.True(t, IsResponseCode(err, "ErrorAccessDenied"))
+	assert.Contains(t, err.Error(), "parent folder 0")
+
+	_, err = checkFindItemResponse(unmarshal(""), parentFolderIds)
+	assert.EqualError(t, err, "expected 2 response messages, got 1")
+}
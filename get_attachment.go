@@ -53,9 +53,20 @@ type Attachments struct {
 	FileAttachment []FileAttachment `xml:"http://schemas.microsoft.com/exchange/services/2006/types FileAttachment,omitempty"`
 }
 
+// ItemAttachment is an item attached to another item, ex: a forwarded message. Set one of
+// Message, CalendarItem, Contact or Task when creating the attachment.
 type ItemAttachment struct {
-	// ItemAttachment typically contains a full Item or Message structure, but based on your XML, it's empty for now.
-	// We'll keep it empty and add fields if needed later.
+	AttachmentId     *AttachmentId `xml:"http://schemas.microsoft.com/exchange/services/2006/types AttachmentId,omitempty"`
+	Name             string        `xml:"http://schemas.microsoft.com/exchange/services/2006/types Name"`
+	ContentType      string        `xml:"http://schemas.microsoft.com/exchange/services/2006/types ContentType,omitempty"`
+	ContentId        string        `xml:"http://schemas.microsoft.com/exchange/services/2006/types ContentId,omitempty"`
+	Size             int64         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Size,omitempty"`
+	LastModifiedTime string        `xml:"http://schemas.microsoft.com/exchange/services/2006/types LastModifiedTime,omitempty"`
+	IsInline         *bool         `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsInline,omitempty"`
+	Message          *Message      `xml:"http://schemas.microsoft.com/exchange/services/2006/types Message,omitempty"`
+	CalendarItem     *CalendarItem `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarItem,omitempty"`
+	Contact          *Contact      `xml:"http://schemas.microsoft.com/exchange/services/2006/types Contact,omitempty"`
+	Task             *Task         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Task,omitempty"`
 }
 
 type FileAttachment struct {
@@ -0,0 +1,179 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+const (
+	FolderClassNote        = "IPF.Note"
+	FolderClassAppointment = "IPF.Appointment"
+	FolderClassContact     = "IPF.Contact"
+	FolderClassTask        = "IPF.Task"
+	FolderClassStickyNote  = "IPF.StickyNote"
+)
+
+// BaseFolder holds the properties shared by all folder types
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/folder
+type BaseFolder struct {
+	FolderId              *FolderId          `xml:"http://schemas.microsoft.com/exchange/services/2006/types FolderId,omitempty"`
+	ParentFolderId        *FolderId          `xml:"http://schemas.microsoft.com/exchange/services/2006/types ParentFolderId,omitempty"`
+	FolderClass           *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types FolderClass,omitempty"`
+	DisplayName           *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types DisplayName,omitempty"`
+	TotalCount            *int               `xml:"http://schemas.microsoft.com/exchange/services/2006/types TotalCount,omitempty"`
+	ChildFolderCount      *int               `xml:"http://schemas.microsoft.com/exchange/services/2006/types ChildFolderCount,omitempty"`
+	ExtendedProperties    []ExtendedProperty `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
+	EffectiveRights       *EffectiveRights   `xml:"http://schemas.microsoft.com/exchange/services/2006/types EffectiveRights,omitempty"`
+	DistinguishedFolderId *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types DistinguishedFolderId,omitempty"`
+}
+
+type Folder struct {
+	BaseFolder
+	PermissionSet *PermissionSet `xml:"http://schemas.microsoft.com/exchange/services/2006/types PermissionSet,omitempty"`
+	UnreadCount   *int           `xml:"http://schemas.microsoft.com/exchange/services/2006/types UnreadCount,omitempty"`
+}
+
+type CalendarFolder struct {
+	BaseFolder
+	SharingEffectiveRights *string                `xml:"http://schemas.microsoft.com/exchange/services/2006/types SharingEffectiveRights,omitempty"`
+	PermissionSet          *CalendarPermissionSet `xml:"http://schemas.microsoft.com/exchange/services/2006/types PermissionSet,omitempty"`
+}
+
+type ContactsFolder struct {
+	BaseFolder
+	SharingEffectiveRights *string        `xml:"http://schemas.microsoft.com/exchange/services/2006/types SharingEffectiveRights,omitempty"`
+	PermissionSet          *PermissionSet `xml:"http://schemas.microsoft.com/exchange/services/2006/types PermissionSet,omitempty"`
+}
+
+type TasksFolder struct {
+	Folder
+}
+
+type SearchFolder struct {
+	Folder
+	SearchParameters *SearchParameters `xml:"http://schemas.microsoft.com/exchange/services/2006/types SearchParameters,omitempty"`
+}
+
+type SearchFolderTraversal string
+
+const (
+	SearchFolderTraversalShallow SearchFolderTraversal = "Shallow"
+	SearchFolderTraversalDeep    SearchFolderTraversal = "Deep"
+)
+
+// SearchParameters is the persistent query of a search folder, evaluated by the server
+// over BaseFolderIds, and their sub folders with SearchFolderTraversalDeep
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/searchparameters
+type SearchParameters struct {
+	Traversal     SearchFolderTraversal `xml:"Traversal,attr,omitempty"`
+	Restriction   Restriction           `xml:"http://schemas.microsoft.com/exchange/services/2006/types Restriction"`
+	BaseFolderIds FolderIds             `xml:"http://schemas.microsoft.com/exchange/services/2006/types BaseFolderIds"`
+}
+
+type Folders struct {
+	Folder         []Folder         `xml:"http://schemas.microsoft.com/exchange/services/2006/types Folder,omitempty"`
+	CalendarFolder []CalendarFolder `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarFolder,omitempty"`
+	ContactsFolder []ContactsFolder `xml:"http://schemas.microsoft.com/exchange/services/2006/types ContactsFolder,omitempty"`
+	SearchFolder   []SearchFolder   `xml:"http://schemas.microsoft.com/exchange/services/2006/types SearchFolder,omitempty"`
+	TasksFolder    []TasksFolder    `xml:"http://schemas.microsoft.com/exchange/services/2006/types TasksFolder,omitempty"`
+}
+
+// All returns the shared properties of the folders of every type
+func (f Folders) All() []BaseFolder {
+	all := make([]BaseFolder, 0, len(f.Folder)+len(f.CalendarFolder)+len(f.ContactsFolder)+len(f.SearchFolder)+len(f.TasksFolder))
+	for _, folder := range f.Folder {
+		all = append(all, folder.BaseFolder)
+	}
+	for _, folder := range f.CalendarFolder {
+		all = append(all, folder.BaseFolder)
+	}
+	for _, folder := range f.ContactsFolder {
+		all = append(all, folder.BaseFolder)
+	}
+	for _, folder := range f.SearchFolder {
+		all = append(all, folder.BaseFolder)
+	}
+	for _, folder := range f.TasksFolder {
+		all = append(all, folder.BaseFolder)
+	}
+	return all
+}
+
+type FolderShape struct {
+	BaseShape            BaseShape             `xml:"http://schemas.microsoft.com/exchange/services/2006/types BaseShape"`
+	AdditionalProperties *AdditionalProperties `xml:"http://schemas.microsoft.com/exchange/services/2006/types AdditionalProperties,omitempty"`
+}
+
+// FolderInfoResponseMessage is the response message of the operations returning folders
+type FolderInfoResponseMessage struct {
+	Response
+	Folders Folders `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Folders"`
+}
+
+type GetFolderRequest struct {
+	XMLName     struct{}    `xml:"http://schemas.microsoft.com/exchange/services/2006/messages GetFolder"`
+	FolderShape FolderShape `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderShape"`
+	FolderIds   FolderIds   `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderIds"`
+}
+
+type GetFolderRequestConfig struct {
+	FolderShape *FolderShape
+}
+
+func NewGetFolderRequest(folderIds FolderIds, config GetFolderRequestConfig) *GetFolderRequest {
+	folderShape := FolderShape{
+		BaseShape: BaseShapeAllProperties,
+	}
+	if config.FolderShape != nil {
+		folderShape = *config.FolderShape
+	}
+
+	return &GetFolderRequest{
+		FolderShape: folderShape,
+		FolderIds:   folderIds,
+	}
+}
+
+type getFolderResponseEnvelope struct {
+	XMLName xml.Name              `xml:"Envelope"`
+	Body    getFolderResponseBody `xml:"Body"`
+}
+
+type getFolderResponseBody struct {
+	GetFolderResponse GetFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages GetFolderResponse"`
+}
+
+type GetFolderResponse struct {
+	ResponseMessages GetFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type GetFolderResponseMessages struct {
+	GetFolderResponseMessage []FolderInfoResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages GetFolderResponseMessage"`
+}
+
+// GetFolder returns the requested folders, one response message per folder
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/getfolder-operation
+func GetFolder(c Client, folderIds FolderIds, config GetFolderRequestConfig) (*GetFolderResponse, error) {
+	xmlBytes, err := xml.MarshalIndent(NewGetFolderRequest(folderIds, config), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp getFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.GetFolderResponse.ResponseMessages.GetFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.GetFolderResponse, nil
+}
@@ -0,0 +1,125 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_FindFolderRequest(t *testing.T) {
+	traversal := FolderTraversalDeep
+	req := NewFindFolderRequest(
+		NewFolderIds(NewDistinguishedTargetFolderId(DistinguishedFolderIdMsgFolderRoot, utils.Ptr("shared@contoso.com"))),
+		FindFolderRequestConfig{
+			Traversal:             &traversal,
+			FolderShape:           &FolderShape{BaseShape: BaseShapeDefault},
+			IndexedPageFolderView: &IndexedPageItemView{MaxEntriesReturned: 100, Offset: 0, BasePoint: BasePointBeginning},
+		},
+	)
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<FindFolder xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" Traversal="Deep">
+  <FolderShape xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <BaseShape xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Default</BaseShape>
+  </FolderShape>
+  <IndexedPageFolderView xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" MaxEntriesReturned="100" Offset="0" BasePoint="Beginning"></IndexedPageFolderView>
+  <ParentFolderIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="msgfolderroot">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">shared@contoso.com</EmailAddress>
+      </Mailbox>
+    </DistinguishedFolderId>
+  </ParentFolderIds>
+</FindFolder>`, string(xmlBytes))
+}
+
+func Test_unmarshal_FindFolderResponse(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:FindFolderResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:FindFolderResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:RootFolder TotalItemsInView="3" IncludesLastItemInRange="true" IndexedPagingOffset="3">
+            <t:Folders>
+              <t:Folder>
+                <t:FolderId Id="AAMkInbox" ChangeKey="AQAAAA" />
+                <t:ParentFolderId Id="AAMkRoot" ChangeKey="AQAAAB" />
+                <t:FolderClass>IPF.Note</t:FolderClass>
+                <t:DisplayName>Inbox</t:DisplayName>
+                <t:TotalCount>42</t:TotalCount>
+                <t:ChildFolderCount>1</t:ChildFolderCount>
+                <t:UnreadCount>7</t:UnreadCount>
+              </t:Folder>
+              <t:CalendarFolder>
+                <t:FolderId Id="AAMkCalendar" ChangeKey="AgAAAA" />
+                <t:FolderClass>IPF.Appointment</t:FolderClass>
+                <t:DisplayName>Calendar</t:DisplayName>
+                <t:TotalCount>5</t:TotalCount>
+                <t:ChildFolderCount>0</t:ChildFolderCount>
+              </t:CalendarFolder>
+              <t:ContactsFolder>
+                <t:FolderId Id="AAMkContacts" ChangeKey="AwAAAA" />
+                <t:FolderClass>IPF.Contact</t:FolderClass>
+                <t:DisplayName>Contacts</t:DisplayName>
+                <t:TotalCount>0</t:TotalCount>
+                <t:ChildFolderCount>0</t:ChildFolderCount>
+              </t:ContactsFolder>
+            </t:Folders>
+          </m:RootFolder>
+        </m:FindFolderResponseMessage>
+      </m:ResponseMessages>
+    </m:FindFolderResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp findFolderResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(soapResp), &resp))
+
+	messages := resp.Body.FindFolderResponse.ResponseMessages.FindFolderResponseMessage
+	require.Len(t, messages, 1)
+	assert.NoError(t, messages[0].Err())
+
+	rootFolder := messages[0].RootFolder
+	assert.Equal(t, 3, rootFolder.IndexedPagingOffset)
+	require.Len(t, rootFolder.Folders.Folder, 1)
+	assert.Equal(t, "AAMkInbox", rootFolder.Folders.Folder[0].FolderId.Id)
+	assert.Equal(t, "AAMkRoot", rootFolder.Folders.Folder[0].ParentFolderId.Id)
+	assert.Equal(t, 7, *rootFolder.Folders.Folder[0].UnreadCount)
+	assert.Equal(t, 1, *rootFolder.Folders.Folder[0].ChildFolderCount)
+
+	var names []string
+	for _, folder := range rootFolder.Folders.All() {
+		names = append(names, *folder.DisplayName)
+	}
+	assert.Equal(t, []string{"Inbox", "Calendar", "Contacts"}, names)
+}
+
+func Test_unmarshal_DeleteFolderResponse_Error(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:DeleteFolderResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages">
+      <m:ResponseMessages>
+        <m:DeleteFolderResponseMessage ResponseClass="Error">
+          <m:MessageText>Distinguished folders cannot be deleted.</m:MessageText>
+          <m:ResponseCode>ErrorDeleteDistinguishedFolder</m:ResponseCode>
+        </m:DeleteFolderResponseMessage>
+      </m:ResponseMessages>
+    </m:DeleteFolderResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp deleteFolderResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(soapResp), &resp))
+
+	err := resp.Body.DeleteFolderResponse.ResponseMessages.DeleteFolderResponseMessage[0].Err()
+	assert.EqualError(t, err, "ErrorDeleteDistinguishedFolder: Distinguished folders cannot be deleted.")
+	assert.True(t, IsResponseCode(err, "ErrorDeleteDistinguishedFolder"))
+}
@@ -3,6 +3,7 @@ package ews
 import (
 	"encoding/xml"
 	"errors"
+	"time"
 )
 
 type GetItemRequest struct {
@@ -59,6 +60,9 @@ type GetItemResponseMessage struct {
 	Items         Items         `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Items"`
 }
 
+// GetItemBody is the body of an item as returned by GetItem.
+//
+// Deprecated: Message.Body and the other bodies of an item use Body, which carries IsTruncated too.
 type GetItemBody struct {
 	BodyType    string `xml:"BodyType,attr"`
 	IsTruncated bool   `xml:"IsTruncated,attr"`
@@ -74,10 +78,12 @@ type InternetMessageHeader struct {
 	Value      string `xml:",chardata"`
 }
 
+// ResponseObjects lists the responses the item allows, ex: ReplyAllToItem is nil when
+// the mailbox can not reply to all recipients
 type ResponseObjects struct {
-	ReplyToItem    struct{} `xml:"ReplyToItem"`
-	ReplyAllToItem struct{} `xml:"ReplyAllToItem"`
-	ForwardItem    struct{} `xml:"ForwardItem"`
+	ReplyToItem    *ReplyToItem    `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReplyToItem,omitempty"`
+	ReplyAllToItem *ReplyAllToItem `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReplyAllToItem,omitempty"`
+	ForwardItem    *ForwardItem    `xml:"http://schemas.microsoft.com/exchange/services/2006/types ForwardItem,omitempty"`
 }
 
 type EffectiveRights struct {
@@ -90,8 +96,20 @@ type EffectiveRights struct {
 	ViewPrivateItems bool `xml:"ViewPrivateItems"`
 }
 
+const (
+	FlagStatusNotFlagged = "NotFlagged"
+	FlagStatusFlagged    = "Flagged"
+	FlagStatusComplete   = "Complete"
+)
+
+// Flag is the follow-up flag of an item, StartDate and DueDate go together and
+// CompleteDate is only set with FlagStatusComplete
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/flag
 type Flag struct {
-	FlagStatus string `xml:"FlagStatus"`
+	FlagStatus   string     `xml:"http://schemas.microsoft.com/exchange/services/2006/types FlagStatus"`
+	StartDate    *time.Time `xml:"http://schemas.microsoft.com/exchange/services/2006/types StartDate,omitempty"`
+	DueDate      *time.Time `xml:"http://schemas.microsoft.com/exchange/services/2006/types DueDate,omitempty"`
+	CompleteDate *time.Time `xml:"http://schemas.microsoft.com/exchange/services/2006/types CompleteDate,omitempty"`
 }
 
 type ConversationId struct {
@@ -1,11 +1,13 @@
 package ews
 
 import (
+	"encoding/xml"
 	"fmt"
 	"os"
 	"testing"
 
 	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -62,3 +64,41 @@ func TestGetItem(t *testing.T) {
 		fmt.Println("category: " + category)
 	}
 }
+
+func Test_unmarshal_GetItemResponse_BodyVariants(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:GetItemResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+        xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:GetItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Items>
+            <t:Message>
+              <t:ItemId Id="AAMkAD" ChangeKey="CQAAAB" />
+              <t:Body BodyType="HTML" IsTruncated="true">&lt;p&gt;Thanks!&lt;/p&gt;</t:Body>
+              <t:UniqueBody BodyType="Text">Thanks!</t:UniqueBody>
+              <t:NormalizedBody BodyType="HTML">&lt;p&gt;Thanks!&lt;/p&gt;</t:NormalizedBody>
+              <t:TextBody BodyType="Text">Thanks! On Monday someone wrote: ...</t:TextBody>
+            </t:Message>
+          </m:Items>
+        </m:GetItemResponseMessage>
+      </m:ResponseMessages>
+    </m:GetItemResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp GetItemResponseEnvelope
+	if err := xml.Unmarshal([]byte(soapResp), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	message := resp.Body.GetItemResponse.ResponseMessages.GetItemResponseMessage.Items.Message[0]
+	assert.True(t, message.Body.IsTruncated)
+	assert.Equal(t, BodyTypeText, message.UniqueBody.BodyType)
+	assert.Equal(t, "Thanks!", string(message.UniqueBody.Body))
+	assert.False(t, message.UniqueBody.IsTruncated)
+	assert.Equal(t, "<p>Thanks!</p>", string(message.NormalizedBody.Body))
+	assert.Equal(t, "Thanks! On Monday someone wrote: ...", string(message.TextBody.Body))
+}
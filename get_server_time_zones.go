@@ -0,0 +1,163 @@
+package ews
+
+import (
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+type GetServerTimeZonesRequest struct {
+	XMLName                struct{}              `xml:"http://schemas.microsoft.com/exchange/services/2006/messages GetServerTimeZones"`
+	ReturnFullTimeZoneData *bool                 `xml:"ReturnFullTimeZoneData,attr,omitempty"`
+	Ids                    *GetServerTimeZoneIds `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Ids,omitempty"`
+}
+
+type GetServerTimeZoneIds struct {
+	Id []string `xml:"http://schemas.microsoft.com/exchange/services/2006/types Id"`
+}
+
+// TimeZoneDefinition describes a time zone either by its Windows id only (which is
+// enough for StartTimeZone, EndTimeZone and TimeZoneContext) or in full, as returned
+// by GetServerTimeZones.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/timezonedefinition
+type TimeZoneDefinition struct {
+	Id                string             `xml:"Id,attr,omitempty"`
+	Name              string             `xml:"Name,attr,omitempty"`
+	Periods           *Periods           `xml:"http://schemas.microsoft.com/exchange/services/2006/types Periods,omitempty"`
+	TransitionsGroups *TransitionsGroups `xml:"http://schemas.microsoft.com/exchange/services/2006/types TransitionsGroups,omitempty"`
+	Transitions       *Transitions       `xml:"http://schemas.microsoft.com/exchange/services/2006/types Transitions,omitempty"`
+}
+
+type Periods struct {
+	Period []Period `xml:"http://schemas.microsoft.com/exchange/services/2006/types Period"`
+}
+
+type Period struct {
+	Bias string `xml:"Bias,attr"` // xs:duration, ex: -PT8H
+	Name string `xml:"Name,attr"`
+	Id   string `xml:"Id,attr"`
+}
+
+type TransitionsGroups struct {
+	TransitionsGroup []TransitionsGroup `xml:"http://schemas.microsoft.com/exchange/services/2006/types TransitionsGroup"`
+}
+
+type TransitionsGroup struct {
+	Id string `xml:"Id,attr"`
+	Transitions
+}
+
+type Transitions struct {
+	Transition              []Transition              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Transition,omitempty"`
+	AbsoluteDateTransition  []AbsoluteDateTransition  `xml:"http://schemas.microsoft.com/exchange/services/2006/types AbsoluteDateTransition,omitempty"`
+	RecurringDayTransition  []RecurringDayTransition  `xml:"http://schemas.microsoft.com/exchange/services/2006/types RecurringDayTransition,omitempty"`
+	RecurringDateTransition []RecurringDateTransition `xml:"http://schemas.microsoft.com/exchange/services/2006/types RecurringDateTransition,omitempty"`
+}
+
+type TransitionTarget struct {
+	Kind  string `xml:"Kind,attr"` // Period or Group
+	Value string `xml:",chardata"`
+}
+
+type Transition struct {
+	To TransitionTarget `xml:"http://schemas.microsoft.com/exchange/services/2006/types To"`
+}
+
+type AbsoluteDateTransition struct {
+	To       TransitionTarget `xml:"http://schemas.microsoft.com/exchange/services/2006/types To"`
+	DateTime string           `xml:"http://schemas.microsoft.com/exchange/services/2006/types DateTime"`
+}
+
+type RecurringDayTransition struct {
+	To         TransitionTarget `xml:"http://schemas.microsoft.com/exchange/services/2006/types To"`
+	TimeOffset string           `xml:"http://schemas.microsoft.com/exchange/services/2006/types TimeOffset"`
+	Month      int              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Month"`
+	DayOfWeek  string           `xml:"http://schemas.microsoft.com/exchange/services/2006/types DayOfWeek"`
+	Occurrence int              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Occurrence"`
+}
+
+type RecurringDateTransition struct {
+	To         TransitionTarget `xml:"http://schemas.microsoft.com/exchange/services/2006/types To"`
+	TimeOffset string           `xml:"http://schemas.microsoft.com/exchange/services/2006/types TimeOffset"`
+	Month      int              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Month"`
+	Day        int              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Day"`
+}
+
+// TimeZoneContext is sent as a SOAP header when Config.TimeZoneContext is set
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/timezonecontext
+type TimeZoneContext struct {
+	XMLName            struct{}           `xml:"http://schemas.microsoft.com/exchange/services/2006/types TimeZoneContext"`
+	TimeZoneDefinition TimeZoneDefinition `xml:"http://schemas.microsoft.com/exchange/services/2006/types TimeZoneDefinition"`
+}
+
+// NewTimeZoneDefinition returns a TimeZoneDefinition referencing the Windows time zone
+// matching loc, ex: Europe/Berlin -> W. Europe Standard Time
+func NewTimeZoneDefinition(loc *time.Location) (*TimeZoneDefinition, error) {
+	id, ok := WindowsTimeZoneId(loc)
+	if !ok {
+		return nil, errors.New("no windows time zone found for location " + loc.String())
+	}
+	return &TimeZoneDefinition{Id: id}, nil
+}
+
+type getServerTimeZonesResponseEnvelope struct {
+	XMLName xml.Name                       `xml:"Envelope"`
+	Body    getServerTimeZonesResponseBody `xml:"Body"`
+}
+
+type getServerTimeZonesResponseBody struct {
+	GetServerTimeZonesResponse GetServerTimeZonesResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages GetServerTimeZonesResponse"`
+}
+
+type GetServerTimeZonesResponse struct {
+	ResponseMessages GetServerTimeZonesResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type GetServerTimeZonesResponseMessages struct {
+	GetServerTimeZonesResponseMessage GetServerTimeZonesResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages GetServerTimeZonesResponseMessage"`
+}
+
+type GetServerTimeZonesResponseMessage struct {
+	ResponseClass       ResponseClass       `xml:"ResponseClass,attr"`
+	MessageText         string              `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MessageText"`
+	ResponseCode        string              `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseCode"`
+	TimeZoneDefinitions TimeZoneDefinitions `xml:"http://schemas.microsoft.com/exchange/services/2006/messages TimeZoneDefinitions"`
+}
+
+type TimeZoneDefinitions struct {
+	TimeZoneDefinition []TimeZoneDefinition `xml:"http://schemas.microsoft.com/exchange/services/2006/types TimeZoneDefinition"`
+}
+
+// GetServerTimeZones returns the time zones known to the server, all of them when ids is empty.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/getservertimezones-operation
+func GetServerTimeZones(c Client, ids []string, returnFullTimeZoneData bool) ([]TimeZoneDefinition, error) {
+	req := GetServerTimeZonesRequest{
+		ReturnFullTimeZoneData: &returnFullTimeZoneData,
+	}
+	if len(ids) > 0 {
+		req.Ids = &GetServerTimeZoneIds{Id: ids}
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp getServerTimeZonesResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := soapResp.Body.GetServerTimeZonesResponse.ResponseMessages.GetServerTimeZonesResponseMessage
+	if resp.ResponseClass == ResponseClassError {
+		return nil, errors.New(resp.ResponseCode)
+	}
+
+	return resp.TimeZoneDefinitions.TimeZoneDefinition, nil
+}
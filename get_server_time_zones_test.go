@@ -0,0 +1,90 @@
+package ews
+
+import (
+	"encoding/xml"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WindowsTimeZoneId(t *testing.T) {
+	tests := []struct {
+		location string
+		want     string
+		wantOk   bool
+	}{
+		{location: "Europe/Berlin", want: "W. Europe Standard Time", wantOk: true},
+		{location: "America/New_York", want: "Eastern Standard Time", wantOk: true},
+		{location: "Asia/Riyadh", want: "Arab Standard Time", wantOk: true},
+		{location: "UTC", want: "UTC", wantOk: true},
+		{location: "Antarctica/Troll", want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.location)
+			if err != nil {
+				t.Skipf("location %s not available: %v", tt.location, err)
+			}
+			got, ok := WindowsTimeZoneId(loc)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_unmarshal_GetServerTimeZonesResponse(t *testing.T) {
+
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:GetServerTimeZonesResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages"
+        xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:GetServerTimeZonesResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:TimeZoneDefinitions>
+            <t:TimeZoneDefinition Name="(UTC-05:00) Eastern Time (US &amp; Canada)" Id="Eastern Standard Time">
+              <t:Periods>
+                <t:Period Bias="PT5H" Name="Standard" Id="trule:Microsoft/Registry/Eastern Standard Time/2007-Standard" />
+                <t:Period Bias="PT4H" Name="Daylight" Id="trule:Microsoft/Registry/Eastern Standard Time/2007-Daylight" />
+              </t:Periods>
+              <t:TransitionsGroups>
+                <t:TransitionsGroup Id="0">
+                  <t:RecurringDayTransition>
+                    <t:To Kind="Period">trule:Microsoft/Registry/Eastern Standard Time/2007-Daylight</t:To>
+                    <t:TimeOffset>PT2H</t:TimeOffset>
+                    <t:Month>3</t:Month>
+                    <t:DayOfWeek>Sunday</t:DayOfWeek>
+                    <t:Occurrence>2</t:Occurrence>
+                  </t:RecurringDayTransition>
+                </t:TransitionsGroup>
+              </t:TransitionsGroups>
+              <t:Transitions>
+                <t:Transition>
+                  <t:To Kind="Group">0</t:To>
+                </t:Transition>
+              </t:Transitions>
+            </t:TimeZoneDefinition>
+          </m:TimeZoneDefinitions>
+        </m:GetServerTimeZonesResponseMessage>
+      </m:ResponseMessages>
+    </m:GetServerTimeZonesResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp getServerTimeZonesResponseEnvelope
+	err := xml.Unmarshal([]byte(soapResp), &resp)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	definitions := resp.Body.GetServerTimeZonesResponse.ResponseMessages.GetServerTimeZonesResponseMessage.TimeZoneDefinitions.TimeZoneDefinition
+	assert.Len(t, definitions, 1)
+	assert.Equal(t, "Eastern Standard Time", definitions[0].Id)
+	assert.Len(t, definitions[0].Periods.Period, 2)
+	assert.Equal(t, "PT5H", definitions[0].Periods.Period[0].Bias)
+	assert.Equal(t, 2, definitions[0].TransitionsGroups.TransitionsGroup[0].RecurringDayTransition[0].Occurrence)
+	assert.Equal(t, "0", definitions[0].Transitions.Transition[0].To.Value)
+}
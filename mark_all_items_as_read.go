@@ -0,0 +1,63 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type MarkAllItemsAsReadRequest struct {
+	XMLName              struct{}  `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MarkAllItemsAsRead"`
+	ReadFlag             bool      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ReadFlag"`
+	SuppressReadReceipts bool      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages SuppressReadReceipts"`
+	FolderIds            FolderIds `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderIds"`
+}
+
+type markAllItemsAsReadResponseEnvelope struct {
+	XMLName xml.Name                       `xml:"Envelope"`
+	Body    markAllItemsAsReadResponseBody `xml:"Body"`
+}
+
+type markAllItemsAsReadResponseBody struct {
+	MarkAllItemsAsReadResponse MarkAllItemsAsReadResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MarkAllItemsAsReadResponse"`
+}
+
+type MarkAllItemsAsReadResponse struct {
+	ResponseMessages MarkAllItemsAsReadResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type MarkAllItemsAsReadResponseMessages struct {
+	MarkAllItemsAsReadResponseMessage []Response `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MarkAllItemsAsReadResponseMessage"`
+}
+
+// MarkAllItemsAsRead marks every item of the folders as read, or unread when readFlag is false
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/markallitemsasread-operation
+func MarkAllItemsAsRead(c Client, folderIds FolderIds, readFlag, suppressReadReceipts bool) (*MarkAllItemsAsReadResponse, error) {
+	req := MarkAllItemsAsReadRequest{
+		ReadFlag:             readFlag,
+		SuppressReadReceipts: suppressReadReceipts,
+		FolderIds:            folderIds,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp markAllItemsAsReadResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.MarkAllItemsAsReadResponse.ResponseMessages.MarkAllItemsAsReadResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.MarkAllItemsAsReadResponse, nil
+}
@@ -0,0 +1,65 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type MarkAsJunkRequest struct {
+	XMLName  struct{} `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MarkAsJunk"`
+	IsJunk   bool     `xml:"IsJunk,attr"`
+	MoveItem bool     `xml:"MoveItem,attr"`
+	ItemIds  ItemIds  `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemIds"`
+}
+
+type markAsJunkResponseEnvelope struct {
+	XMLName xml.Name               `xml:"Envelope"`
+	Body    markAsJunkResponseBody `xml:"Body"`
+}
+
+type markAsJunkResponseBody struct {
+	MarkAsJunkResponse MarkAsJunkResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MarkAsJunkResponse"`
+}
+
+type MarkAsJunkResponse struct {
+	ResponseMessages MarkAsJunkResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+// MarkAsJunkResponseMessages holds one response message per item, in the order of the request
+type MarkAsJunkResponseMessages struct {
+	MarkAsJunkResponseMessage []MarkAsJunkResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MarkAsJunkResponseMessage"`
+}
+
+type MarkAsJunkResponseMessage struct {
+	Response
+	MovedItemId *ItemId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MovedItemId"`
+}
+
+// MarkAsJunk adds the senders of the items to the blocked senders list, or removes them from it
+// when isJunk is false, and moves the items to Junk Email, or back to Inbox, when moveItem is set.
+// Items failing individually do not fail the call, check the Err of each response message.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/markasjunk-operation
+func MarkAsJunk(c Client, itemIds []ItemId, isJunk, moveItem bool) (*MarkAsJunkResponse, error) {
+	req := MarkAsJunkRequest{
+		IsJunk:   isJunk,
+		MoveItem: moveItem,
+		ItemIds:  ItemIds{ItemId: itemIds},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp markAsJunkResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &soapResp.Body.MarkAsJunkResponse, nil
+}
@@ -0,0 +1,52 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_MarkAllItemsAsReadRequest(t *testing.T) {
+	req := MarkAllItemsAsReadRequest{
+		ReadFlag:             true,
+		SuppressReadReceipts: true,
+		FolderIds:            NewFolderIds(NewDistinguishedTargetFolderId(DistinguishedFolderIdInbox, nil)),
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<MarkAllItemsAsRead xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+  <ReadFlag xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">true</ReadFlag>
+  <SuppressReadReceipts xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">true</SuppressReadReceipts>
+  <FolderIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="inbox"></DistinguishedFolderId>
+  </FolderIds>
+</MarkAllItemsAsRead>`, string(xmlBytes))
+}
+
+func Test_unmarshal_MarkAsJunkResponse(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:MarkAsJunkResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages">
+      <m:ResponseMessages>
+        <m:MarkAsJunkResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:MovedItemId Id="AAMkJunk1" ChangeKey="CQAAAA" />
+        </m:MarkAsJunkResponseMessage>
+      </m:ResponseMessages>
+    </m:MarkAsJunkResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp markAsJunkResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(soapResp), &resp))
+
+	messages := resp.Body.MarkAsJunkResponse.ResponseMessages.MarkAsJunkResponseMessage
+	require.Len(t, messages, 1)
+	assert.NoError(t, messages[0].Err())
+	assert.Equal(t, &ItemId{Id: "AAMkJunk1", ChangeKey: "CQAAAA"}, messages[0].MovedItemId)
+}
@@ -0,0 +1,74 @@
+package ews
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"net/mail"
+)
+
+// MimeContent is the base64 encoded RFC 5322 / MIME form of an item.
+// CharacterSet names the charset of the MIME headers and parts that do not declare one.
+type MimeContent struct {
+	CharacterSet string `xml:"CharacterSet,attr,omitempty"`
+	Content      string `xml:",chardata"`
+}
+
+// NewMimeContent encodes a MIME message, taking CharacterSet from the charset of its
+// top level Content-Type header when it has one
+func NewMimeContent(raw []byte) *MimeContent {
+	return &MimeContent{
+		CharacterSet: mimeCharset(raw),
+		Content:      base64.StdEncoding.EncodeToString(raw),
+	}
+}
+
+// ReadMimeContent reads a MIME message from r, see NewMimeContent
+func ReadMimeContent(r io.Reader) (*MimeContent, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewMimeContent(raw), nil
+}
+
+// Bytes returns the decoded MIME message
+func (m *MimeContent) Bytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(m.Content)
+}
+
+// MailMessage parses the MIME content of the message, which GetItem returns when the
+// ItemShape sets IncludeMimeContent
+func (m *Message) MailMessage() (*mail.Message, error) {
+	if m.MimeContent == nil {
+		return nil, errors.New("message has no mime content, set IncludeMimeContent on the item shape")
+	}
+	raw, err := m.MimeContent.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return mail.ReadMessage(bytes.NewReader(raw))
+}
+
+func mimeCharset(raw []byte) string {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return ""
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return params["charset"]
+}
+
+// CreateMimeMessage creates a message from its MIME content, which the server keeps as is.
+// With the SaveOnly disposition the message is saved in SavedItemFolderId and its id is returned,
+// otherwise the message is sent, a copy saved in SavedItemFolderId with SendAndSaveCopy,
+// and the returned id is nil.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/mimecontent
+func CreateMimeMessage(c Client, mimeContent *MimeContent, config CreateItemRequestConfig) (*ItemId, error) {
+	return createItem(c, Message{MimeContent: mimeContent}, config)
+}
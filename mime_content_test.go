@@ -0,0 +1,34 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewMimeContent(t *testing.T) {
+	raw := []byte("From: jane@contoso.com\r\nTo: john@contoso.com\r\nSubject: Hi\r\nContent-Type: text/plain; charset=\"ISO-8859-1\"\r\n\r\nHello\r\n")
+	mimeContent := NewMimeContent(raw)
+	assert.Equal(t, "ISO-8859-1", mimeContent.CharacterSet)
+
+	decoded, err := mimeContent.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, raw, decoded)
+
+	req, err := NewCreateItemRequest(Message{MimeContent: NewMimeContent([]byte("Subject: Hi\r\n\r\nHello"))}, CreateItemRequestConfig{
+		MessageDisposition: MessageDispositionSendOnly,
+	})
+	require.NoError(t, err)
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, `<CreateItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" MessageDisposition="SendOnly">
+  <Items xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <Message xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <MimeContent xmlns="http://schemas.microsoft.com/exchange/services/2006/types">U3ViamVjdDogSGkNCg0KSGVsbG8=</MimeContent>
+    </Message>
+  </Items>
+</CreateItem>`, string(xmlBytes))
+}
@@ -0,0 +1,61 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type MoveFolderRequest struct {
+	XMLName    struct{}       `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MoveFolder"`
+	ToFolderId TargetFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ToFolderId"`
+	FolderIds  FolderIds      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderIds"`
+}
+
+type moveFolderResponseEnvelope struct {
+	XMLName xml.Name               `xml:"Envelope"`
+	Body    moveFolderResponseBody `xml:"Body"`
+}
+
+type moveFolderResponseBody struct {
+	MoveFolderResponse MoveFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MoveFolderResponse"`
+}
+
+type MoveFolderResponse struct {
+	ResponseMessages MoveFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type MoveFolderResponseMessages struct {
+	MoveFolderResponseMessage []FolderInfoResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MoveFolderResponseMessage"`
+}
+
+// MoveFolder moves the folders into toFolderId, the response holds their new FolderId
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/movefolder-operation
+func MoveFolder(c Client, toFolderId TargetFolderId, folderIds FolderIds) (*MoveFolderResponse, error) {
+	req := MoveFolderRequest{
+		ToFolderId: toFolderId,
+		FolderIds:  folderIds,
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp moveFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.MoveFolderResponse.ResponseMessages.MoveFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.MoveFolderResponse, nil
+}
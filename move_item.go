@@ -0,0 +1,81 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+// BaseMoveCopyItem holds the fields shared by the MoveItem and CopyItem requests
+type BaseMoveCopyItem struct {
+	ToFolderId       TargetFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ToFolderId"`
+	ItemIds          ItemIds        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemIds"`
+	ReturnNewItemIds *bool          `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ReturnNewItemIds,omitempty"`
+}
+
+func newBaseMoveCopyItem(itemIds []ItemId, toFolderId TargetFolderId) BaseMoveCopyItem {
+	returnNewItemIds := true
+	return BaseMoveCopyItem{
+		ToFolderId:       toFolderId,
+		ItemIds:          ItemIds{ItemId: itemIds},
+		ReturnNewItemIds: &returnNewItemIds,
+	}
+}
+
+type MoveItemRequest struct {
+	XMLName struct{} `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MoveItem"`
+	BaseMoveCopyItem
+}
+
+type moveCopyItemResponseEnvelope struct {
+	XMLName xml.Name                 `xml:"Envelope"`
+	Body    moveCopyItemResponseBody `xml:"Body"`
+}
+
+type moveCopyItemResponseBody struct {
+	// the MoveItemResponse or CopyItemResponse element
+	MoveCopyItemResponse MoveCopyItemResponse `xml:",any"`
+}
+
+// MoveCopyItemResponse is the response of MoveItem and CopyItem
+type MoveCopyItemResponse struct {
+	ResponseMessages MoveCopyItemResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type (
+	MoveItemResponse = MoveCopyItemResponse
+	CopyItemResponse = MoveCopyItemResponse
+)
+
+// MoveCopyItemResponseMessages holds one response message per item, in the order of the request,
+// with the new item id in Items unless the item changed mailbox
+type MoveCopyItemResponseMessages struct {
+	// the MoveItemResponseMessage or CopyItemResponseMessage elements
+	ResponseMessage []Response `xml:",any"`
+}
+
+// MoveItem moves the items into toFolderId, which may be in another mailbox. Items failing
+// individually do not fail the call, check the Err of each response message.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/moveitem-operation
+func MoveItem(c Client, itemIds []ItemId, toFolderId TargetFolderId) (*MoveItemResponse, error) {
+	return moveCopyItem(c, MoveItemRequest{BaseMoveCopyItem: newBaseMoveCopyItem(itemIds, toFolderId)})
+}
+
+// moveCopyItem sends a MoveItemRequest or a CopyItemRequest
+func moveCopyItem(c Client, req any) (*MoveCopyItemResponse, error) {
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp moveCopyItemResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &soapResp.Body.MoveCopyItemResponse, nil
+}
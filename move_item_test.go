@@ -0,0 +1,91 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_MoveItemRequest(t *testing.T) {
+	req := MoveItemRequest{BaseMoveCopyItem: newBaseMoveCopyItem(
+		[]ItemId{{Id: "AAMkA1"}},
+		NewDistinguishedTargetFolderId(DistinguishedFolderIdInbox, utils.Ptr("shared@contoso.com")),
+	)}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<MoveItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+  <ToFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="inbox">
+      <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">shared@contoso.com</EmailAddress>
+      </Mailbox>
+    </DistinguishedFolderId>
+  </ToFolderId>
+  <ItemIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkA1"></ItemId>
+  </ItemIds>
+  <ReturnNewItemIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">true</ReturnNewItemIds>
+</MoveItem>`, string(xmlBytes))
+}
+
+func Test_unmarshal_CopyItemResponse(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:CopyItemResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:CopyItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Items>
+            <t:Message>
+              <t:ItemId Id="AAMkNew1" ChangeKey="CQAAAA" />
+            </t:Message>
+          </m:Items>
+        </m:CopyItemResponseMessage>
+        <m:CopyItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Items>
+            <t:CalendarItem>
+              <t:ItemId Id="AAMkNew2" ChangeKey="DwAAAA" />
+            </t:CalendarItem>
+          </m:Items>
+        </m:CopyItemResponseMessage>
+      </m:ResponseMessages>
+    </m:CopyItemResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp moveCopyItemResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(soapResp), &resp))
+
+	var newItemIds []ItemId
+	for _, message := range resp.Body.MoveCopyItemResponse.ResponseMessages.ResponseMessage {
+		require.NoError(t, message.Err())
+		newItemIds = append(newItemIds, message.Items.ItemIds()...)
+	}
+	assert.Equal(t, []ItemId{{Id: "AAMkNew1", ChangeKey: "CQAAAA"}, {Id: "AAMkNew2", ChangeKey: "DwAAAA"}}, newItemIds)
+}
+
+func Test_marshal_ArchiveItemRequest(t *testing.T) {
+	req := ArchiveItemRequest{
+		ArchiveSourceFolderId: NewDistinguishedTargetFolderId(DistinguishedFolderIdInbox, nil),
+		ItemIds:               ItemIds{ItemId: []ItemId{{Id: "AAMkA1"}}},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<ArchiveItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+  <ArchiveSourceFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="inbox"></DistinguishedFolderId>
+  </ArchiveSourceFolderId>
+  <ItemIds xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkA1"></ItemId>
+  </ItemIds>
+</ArchiveItem>`, string(xmlBytes))
+}
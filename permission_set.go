@@ -0,0 +1,131 @@
+package ews
+
+// PermissionLevel is the role of a user on a mail, contacts or tasks folder,
+// PermissionLevelCustom when the individual rights do not match a role
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/permissionlevel
+type PermissionLevel string
+
+const (
+	PermissionLevelNone             PermissionLevel = "None"
+	PermissionLevelOwner            PermissionLevel = "Owner"
+	PermissionLevelPublishingEditor PermissionLevel = "PublishingEditor"
+	PermissionLevelEditor           PermissionLevel = "Editor"
+	PermissionLevelPublishingAuthor PermissionLevel = "PublishingAuthor"
+	PermissionLevelAuthor           PermissionLevel = "Author"
+	PermissionLevelNoneditingAuthor PermissionLevel = "NoneditingAuthor"
+	PermissionLevelReviewer         PermissionLevel = "Reviewer"
+	PermissionLevelContributor      PermissionLevel = "Contributor"
+	PermissionLevelCustom           PermissionLevel = "Custom"
+)
+
+// CalendarPermissionLevel is the role of a user on a calendar folder, it adds the free/busy roles
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/calendarpermissionlevel
+type CalendarPermissionLevel string
+
+const (
+	CalendarPermissionLevelNone                              CalendarPermissionLevel = "None"
+	CalendarPermissionLevelOwner                             CalendarPermissionLevel = "Owner"
+	CalendarPermissionLevelPublishingEditor                  CalendarPermissionLevel = "PublishingEditor"
+	CalendarPermissionLevelEditor                            CalendarPermissionLevel = "Editor"
+	CalendarPermissionLevelPublishingAuthor                  CalendarPermissionLevel = "PublishingAuthor"
+	CalendarPermissionLevelAuthor                            CalendarPermissionLevel = "Author"
+	CalendarPermissionLevelNoneditingAuthor                  CalendarPermissionLevel = "NoneditingAuthor"
+	CalendarPermissionLevelReviewer                          CalendarPermissionLevel = "Reviewer"
+	CalendarPermissionLevelContributor                       CalendarPermissionLevel = "Contributor"
+	CalendarPermissionLevelFreeBusyTimeOnly                  CalendarPermissionLevel = "FreeBusyTimeOnly"
+	CalendarPermissionLevelFreeBusyTimeAndSubjectAndLocation CalendarPermissionLevel = "FreeBusyTimeAndSubjectAndLocation"
+	CalendarPermissionLevelCustom                            CalendarPermissionLevel = "Custom"
+)
+
+// PermissionAction is the set of items a user may edit or delete
+type PermissionAction string
+
+const (
+	PermissionActionNone  PermissionAction = "None"
+	PermissionActionOwned PermissionAction = "Owned"
+	PermissionActionAll   PermissionAction = "All"
+)
+
+type PermissionReadAccess string
+
+const (
+	PermissionReadAccessNone        PermissionReadAccess = "None"
+	PermissionReadAccessFullDetails PermissionReadAccess = "FullDetails"
+)
+
+type CalendarPermissionReadAccess string
+
+const (
+	CalendarPermissionReadAccessNone                      CalendarPermissionReadAccess = "None"
+	CalendarPermissionReadAccessTimeOnly                  CalendarPermissionReadAccess = "TimeOnly"
+	CalendarPermissionReadAccessTimeAndSubjectAndLocation CalendarPermissionReadAccess = "TimeAndSubjectAndLocation"
+	CalendarPermissionReadAccessFullDetails               CalendarPermissionReadAccess = "FullDetails"
+)
+
+const (
+	DistinguishedUserDefault   = "Default"
+	DistinguishedUserAnonymous = "Anonymous"
+)
+
+// UserId identifies the user of a permission, either by address or as the
+// Default (any authenticated user) or Anonymous distinguished user
+type UserId struct {
+	SID                *string `xml:"http://schemas.microsoft.com/exchange/services/2006/types SID,omitempty"`
+	PrimarySmtpAddress *string `xml:"http://schemas.microsoft.com/exchange/services/2006/types PrimarySmtpAddress,omitempty"`
+	DisplayName        *string `xml:"http://schemas.microsoft.com/exchange/services/2006/types DisplayName,omitempty"`
+	DistinguishedUser  *string `xml:"http://schemas.microsoft.com/exchange/services/2006/types DistinguishedUser,omitempty"`
+}
+
+// PermissionSet holds the permissions of a mail, contacts or tasks folder
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/permissionset-permissionsettype
+type PermissionSet struct {
+	Permissions    *Permissions    `xml:"http://schemas.microsoft.com/exchange/services/2006/types Permissions,omitempty"`
+	UnknownEntries *UnknownEntries `xml:"http://schemas.microsoft.com/exchange/services/2006/types UnknownEntries,omitempty"`
+}
+
+type Permissions struct {
+	Permission []Permission `xml:"http://schemas.microsoft.com/exchange/services/2006/types Permission"`
+}
+
+// Permission grants either a PermissionLevel or, with PermissionLevelCustom, the individual rights
+type Permission struct {
+	UserId              UserId                `xml:"http://schemas.microsoft.com/exchange/services/2006/types UserId"`
+	CanCreateItems      *bool                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types CanCreateItems,omitempty"`
+	CanCreateSubFolders *bool                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types CanCreateSubFolders,omitempty"`
+	IsFolderOwner       *bool                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFolderOwner,omitempty"`
+	IsFolderVisible     *bool                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFolderVisible,omitempty"`
+	IsFolderContact     *bool                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFolderContact,omitempty"`
+	EditItems           *PermissionAction     `xml:"http://schemas.microsoft.com/exchange/services/2006/types EditItems,omitempty"`
+	DeleteItems         *PermissionAction     `xml:"http://schemas.microsoft.com/exchange/services/2006/types DeleteItems,omitempty"`
+	ReadItems           *PermissionReadAccess `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReadItems,omitempty"`
+	PermissionLevel     PermissionLevel       `xml:"http://schemas.microsoft.com/exchange/services/2006/types PermissionLevel"`
+}
+
+// CalendarPermissionSet holds the permissions of a calendar folder
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/permissionset-calendarpermissionsettype
+type CalendarPermissionSet struct {
+	CalendarPermissions *CalendarPermissions `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarPermissions,omitempty"`
+	UnknownEntries      *UnknownEntries      `xml:"http://schemas.microsoft.com/exchange/services/2006/types UnknownEntries,omitempty"`
+}
+
+type CalendarPermissions struct {
+	CalendarPermission []CalendarPermission `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarPermission"`
+}
+
+type CalendarPermission struct {
+	UserId                  UserId                        `xml:"http://schemas.microsoft.com/exchange/services/2006/types UserId"`
+	CanCreateItems          *bool                         `xml:"http://schemas.microsoft.com/exchange/services/2006/types CanCreateItems,omitempty"`
+	CanCreateSubFolders     *bool                         `xml:"http://schemas.microsoft.com/exchange/services/2006/types CanCreateSubFolders,omitempty"`
+	IsFolderOwner           *bool                         `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFolderOwner,omitempty"`
+	IsFolderVisible         *bool                         `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFolderVisible,omitempty"`
+	IsFolderContact         *bool                         `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsFolderContact,omitempty"`
+	EditItems               *PermissionAction             `xml:"http://schemas.microsoft.com/exchange/services/2006/types EditItems,omitempty"`
+	DeleteItems             *PermissionAction             `xml:"http://schemas.microsoft.com/exchange/services/2006/types DeleteItems,omitempty"`
+	ReadItems               *CalendarPermissionReadAccess `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReadItems,omitempty"`
+	CalendarPermissionLevel CalendarPermissionLevel       `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarPermissionLevel"`
+}
+
+// UnknownEntries are the permission entries of users that no longer resolve
+type UnknownEntries struct {
+	UnknownEntry []string `xml:"http://schemas.microsoft.com/exchange/services/2006/types UnknownEntry"`
+}
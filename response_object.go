@@ -0,0 +1,34 @@
+package ews
+
+// SmartResponse is the content of a reply, reply-all or forward of the ReferenceItemId item.
+// The server quotes the original message below NewBodyContent and sets the conversation
+// headers (In-Reply-To, References, Thread-Index) of the response.
+// Fields follow the order of the EWS schema, which does not allow attachments: save the
+// response as a draft, then add them with CreateAttachment before sending it.
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/replytoitem
+type SmartResponse struct {
+	Subject                    *string     `xml:"http://schemas.microsoft.com/exchange/services/2006/types Subject,omitempty"`
+	Body                       *Body       `xml:"http://schemas.microsoft.com/exchange/services/2006/types Body,omitempty"`
+	ToRecipients               *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ToRecipients,omitempty"`
+	CcRecipients               *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types CcRecipients,omitempty"`
+	BccRecipients              *XMailbox   `xml:"http://schemas.microsoft.com/exchange/services/2006/types BccRecipients,omitempty"`
+	IsReadReceiptRequested     *bool       `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsReadReceiptRequested,omitempty"`
+	IsDeliveryReceiptRequested *bool       `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsDeliveryReceiptRequested,omitempty"`
+	From                       *OneMailbox `xml:"http://schemas.microsoft.com/exchange/services/2006/types From,omitempty"`
+	ReferenceItemId            *ItemId     `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReferenceItemId,omitempty"`
+	NewBodyContent             *Body       `xml:"http://schemas.microsoft.com/exchange/services/2006/types NewBodyContent,omitempty"`
+}
+
+type (
+	ReplyToItem    SmartResponse
+	ReplyAllToItem SmartResponse
+	ForwardItem    SmartResponse
+)
+
+// CreateSmartResponse creates a ReplyToItem, ReplyAllToItem or ForwardItem. With the SaveOnly
+// disposition the response is saved in SavedItemFolderId and its id is returned, otherwise
+// the response is sent and the returned id is nil.
+// https://docs.microsoft.com/en-us/exchange/client-developer/exchange-web-services/how-to-respond-to-email-messages-by-using-ews-in-exchange
+func CreateSmartResponse(c Client, response any, config CreateItemRequestConfig) (*ItemId, error) {
+	return createItem(c, response, config)
+}
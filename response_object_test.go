@@ -0,0 +1,50 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_ReplyAllToItem(t *testing.T) {
+	req, err := NewCreateItemRequest(ReplyAllToItem{
+		CcRecipients:    &XMailbox{Mailbox: []Mailbox{{EmailAddress: "support@contoso.com"}}},
+		ReferenceItemId: &ItemId{Id: "AAMkMessage", ChangeKey: "CQAAAA"},
+		NewBodyContent:  &Body{BodyType: "HTML", Body: []byte("<p>Thanks, looking into it</p>")},
+	}, CreateItemRequestConfig{MessageDisposition: MessageDispositionSendAndSaveCopy})
+	require.NoError(t, err)
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<CreateItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" MessageDisposition="SendAndSaveCopy">
+  <Items xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <ReplyAllToItem xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <CcRecipients xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <Mailbox xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <EmailAddress xmlns="http://schemas.microsoft.com/exchange/services/2006/types">support@contoso.com</EmailAddress>
+        </Mailbox>
+      </CcRecipients>
+      <ReferenceItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkMessage" ChangeKey="CQAAAA"></ReferenceItemId>
+      <NewBodyContent xmlns="http://schemas.microsoft.com/exchange/services/2006/types" BodyType="HTML">&lt;p&gt;Thanks, looking into it&lt;/p&gt;</NewBodyContent>
+    </ReplyAllToItem>
+  </Items>
+</CreateItem>`, string(xmlBytes))
+}
+
+func Test_unmarshal_ResponseObjects(t *testing.T) {
+	var message Message
+	require.NoError(t, xml.Unmarshal([]byte(`<t:Message xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+  <t:ResponseObjects>
+    <t:ReplyToItem />
+    <t:ForwardItem />
+  </t:ResponseObjects>
+</t:Message>`), &message))
+
+	require.NotNil(t, message.ResponseObjects)
+	assert.NotNil(t, message.ResponseObjects.ReplyToItem)
+	assert.Nil(t, message.ResponseObjects.ReplyAllToItem)
+	assert.NotNil(t, message.ResponseObjects.ForwardItem)
+}
@@ -0,0 +1,114 @@
+package ews
+
+// Restriction holds a single search expression, ex:
+//
+//	Restriction{And: &And{SearchExpressions{
+//		IsEqualTo: []IsEqualTo{{FieldURI: &FieldURI{FieldURI: "message:IsRead"}, FieldURIOrConstant: NewConstant("false")}},
+//		Exists:    []Exists{{FieldURI: &FieldURI{FieldURI: "item:Categories"}}},
+//	}}}
+//
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/restriction
+type Restriction struct {
+	IsEqualTo              *IsEqualTo              `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsEqualTo,omitempty"`
+	IsNotEqualTo           *IsNotEqualTo           `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsNotEqualTo,omitempty"`
+	IsGreaterThan          *IsGreaterThan          `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsGreaterThan,omitempty"`
+	IsGreaterThanOrEqualTo *IsGreaterThanOrEqualTo `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsGreaterThanOrEqualTo,omitempty"`
+	IsLessThan             *IsLessThan             `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsLessThan,omitempty"`
+	IsLessThanOrEqualTo    *IsLessThanOrEqualTo    `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsLessThanOrEqualTo,omitempty"`
+	Contains               *Contains               `xml:"http://schemas.microsoft.com/exchange/services/2006/types Contains,omitempty"`
+	Exists                 *Exists                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Exists,omitempty"`
+	Not                    *Not                    `xml:"http://schemas.microsoft.com/exchange/services/2006/types Not,omitempty"`
+	And                    *And                    `xml:"http://schemas.microsoft.com/exchange/services/2006/types And,omitempty"`
+	Or                     *Or                     `xml:"http://schemas.microsoft.com/exchange/services/2006/types Or,omitempty"`
+}
+
+// Not negates its single search expression
+type Not Restriction
+
+// SearchExpressions are the operands of And and Or, grouped by kind
+type SearchExpressions struct {
+	IsEqualTo              []IsEqualTo              `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsEqualTo,omitempty"`
+	IsNotEqualTo           []IsNotEqualTo           `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsNotEqualTo,omitempty"`
+	IsGreaterThan          []IsGreaterThan          `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsGreaterThan,omitempty"`
+	IsGreaterThanOrEqualTo []IsGreaterThanOrEqualTo `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsGreaterThanOrEqualTo,omitempty"`
+	IsLessThan             []IsLessThan             `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsLessThan,omitempty"`
+	IsLessThanOrEqualTo    []IsLessThanOrEqualTo    `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsLessThanOrEqualTo,omitempty"`
+	Contains               []Contains               `xml:"http://schemas.microsoft.com/exchange/services/2006/types Contains,omitempty"`
+	Exists                 []Exists                 `xml:"http://schemas.microsoft.com/exchange/services/2006/types Exists,omitempty"`
+	Not                    []Not                    `xml:"http://schemas.microsoft.com/exchange/services/2006/types Not,omitempty"`
+	And                    []And                    `xml:"http://schemas.microsoft.com/exchange/services/2006/types And,omitempty"`
+	Or                     []Or                     `xml:"http://schemas.microsoft.com/exchange/services/2006/types Or,omitempty"`
+}
+
+type And struct {
+	SearchExpressions
+}
+
+type Or struct {
+	SearchExpressions
+}
+
+// TwoOperandExpression compares a property with a constant or another property
+type TwoOperandExpression struct {
+	FieldURI           *FieldURI           `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	ExtendedFieldURI   *ExtendedFieldURI   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
+	FieldURIOrConstant *FieldURIOrConstant `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURIOrConstant"`
+}
+
+type (
+	IsEqualTo              = TwoOperandExpression
+	IsNotEqualTo           = TwoOperandExpression
+	IsGreaterThan          = TwoOperandExpression
+	IsGreaterThanOrEqualTo = TwoOperandExpression
+	IsLessThan             = TwoOperandExpression
+	IsLessThanOrEqualTo    = TwoOperandExpression
+)
+
+type FieldURIOrConstant struct {
+	FieldURI *FieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	Constant *Constant `xml:"http://schemas.microsoft.com/exchange/services/2006/types Constant,omitempty"`
+}
+
+type Constant struct {
+	Value string `xml:"Value,attr"`
+}
+
+// NewConstant returns the FieldURIOrConstant of a constant value
+func NewConstant(value string) *FieldURIOrConstant {
+	return &FieldURIOrConstant{Constant: &Constant{Value: value}}
+}
+
+type ContainmentMode string
+
+const (
+	ContainmentModeFullString    ContainmentMode = "FullString"
+	ContainmentModePrefixed      ContainmentMode = "Prefixed"
+	ContainmentModeSubstring     ContainmentMode = "Substring"
+	ContainmentModePrefixOnWords ContainmentMode = "PrefixOnWords"
+	ContainmentModeExactPhrase   ContainmentMode = "ExactPhrase"
+)
+
+type ContainmentComparison string
+
+const (
+	ContainmentComparisonExact                             ContainmentComparison = "Exact"
+	ContainmentComparisonIgnoreCase                        ContainmentComparison = "IgnoreCase"
+	ContainmentComparisonIgnoreNonSpacingCharacters        ContainmentComparison = "IgnoreNonSpacingCharacters"
+	ContainmentComparisonLoose                             ContainmentComparison = "Loose"
+	ContainmentComparisonIgnoreCaseAndNonSpacingCharacters ContainmentComparison = "IgnoreCaseAndNonSpacingCharacters"
+)
+
+// Contains matches string properties, and the values of multi-valued ones, holding Constant
+type Contains struct {
+	ContainmentMode       ContainmentMode       `xml:"ContainmentMode,attr,omitempty"`
+	ContainmentComparison ContainmentComparison `xml:"ContainmentComparison,attr,omitempty"`
+	FieldURI              *FieldURI             `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	ExtendedFieldURI      *ExtendedFieldURI     `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
+	Constant              Constant              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Constant"`
+}
+
+// Exists matches items having the property set
+type Exists struct {
+	FieldURI         *FieldURI         `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
+}
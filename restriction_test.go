@@ -0,0 +1,64 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hoshii-ai/ews/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_marshal_SearchFolder(t *testing.T) {
+	folder := SearchFolder{
+		Folder: Folder{BaseFolder: BaseFolder{DisplayName: utils.Ptr("Needs reply")}},
+		SearchParameters: &SearchParameters{
+			Traversal: SearchFolderTraversalDeep,
+			Restriction: Restriction{
+				And: &And{SearchExpressions{
+					IsEqualTo: []IsEqualTo{{
+						FieldURI:           &FieldURI{FieldURI: "message:IsRead"},
+						FieldURIOrConstant: NewConstant("false"),
+					}},
+					Not: []Not{{
+						Contains: &Contains{
+							ContainmentMode:       ContainmentModeSubstring,
+							ContainmentComparison: ContainmentComparisonIgnoreCase,
+							FieldURI:              &FieldURI{FieldURI: "item:Subject"},
+							Constant:              Constant{Value: "newsletter"},
+						},
+					}},
+				}},
+			},
+			BaseFolderIds: NewFolderIds(NewDistinguishedTargetFolderId(DistinguishedFolderIdMsgFolderRoot, nil)),
+		},
+	}
+
+	xmlBytes, err := xml.MarshalIndent(folder, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<SearchFolder>
+  <DisplayName xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Needs reply</DisplayName>
+  <SearchParameters xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Traversal="Deep">
+    <Restriction xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <And xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <IsEqualTo xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="message:IsRead"></FieldURI>
+          <FieldURIOrConstant xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+            <Constant xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Value="false"></Constant>
+          </FieldURIOrConstant>
+        </IsEqualTo>
+        <Not xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <Contains xmlns="http://schemas.microsoft.com/exchange/services/2006/types" ContainmentMode="Substring" ContainmentComparison="IgnoreCase">
+            <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="item:Subject"></FieldURI>
+            <Constant xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Value="newsletter"></Constant>
+          </Contains>
+        </Not>
+      </And>
+    </Restriction>
+    <BaseFolderIds xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <DistinguishedFolderId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="msgfolderroot"></DistinguishedFolderId>
+    </BaseFolderIds>
+  </SearchParameters>
+</SearchFolder>`, string(xmlBytes))
+}
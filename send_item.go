@@ -8,6 +8,8 @@ type SendItemRequest struct {
 	XMLName          struct{} `xml:"http://schemas.microsoft.com/exchange/services/2006/messages SendItem"`
 	SaveItemToFolder string   `xml:"SaveItemToFolder,attr"` // true or false
 	ItemIds          ItemIds  `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemIds"`
+	// SavedItemFolderId is where the sent copy goes, the sent items folder of the caller when nil
+	SavedItemFolderId *SavedItemFolderId `xml:"http://schemas.microsoft.com/exchange/services/2006/messages SavedItemFolderId,omitempty"`
 }
 
 // --- Response ---
@@ -33,6 +35,7 @@ type SendItemResponseMessages struct {
 
 type SendItemResponseMessage struct {
 	ResponseClass ResponseClass `xml:"ResponseClass,attr"`
+	MessageText   string        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MessageText"`
 	ResponseCode  string        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseCode"`
 }
 
@@ -42,10 +45,23 @@ func SendItem(c Client, itemId ItemId, saveItemToFolder bool) (*SendItemResponse
 	if !saveItemToFolder {
 		saveItemToFolderStr = "false"
 	}
-	req := SendItemRequest{
+	return sendItem(c, SendItemRequest{
 		SaveItemToFolder: saveItemToFolderStr,
 		ItemIds:          ItemIds{ItemId: []ItemId{itemId}},
-	}
+	})
+}
+
+// SendItemAndSaveTo sends an item and saves the sent copy in savedItemFolderId,
+// ex: the sent items folder of the shared mailbox the item is sent from
+func SendItemAndSaveTo(c Client, itemId ItemId, savedItemFolderId SavedItemFolderId) (*SendItemResponse, error) {
+	return sendItem(c, SendItemRequest{
+		SaveItemToFolder:  "true",
+		ItemIds:           ItemIds{ItemId: []ItemId{itemId}},
+		SavedItemFolderId: &savedItemFolderId,
+	})
+}
+
+func sendItem(c Client, req SendItemRequest) (*SendItemResponse, error) {
 	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
 	if err != nil {
 		return nil, err
@@ -63,5 +79,10 @@ func SendItem(c Client, itemId ItemId, saveItemToFolder bool) (*SendItemResponse
 		return nil, err
 	}
 
+	resp := soapResp.Body.SendItemResponse.ResponseMessages.SendItemResponseMessage
+	if resp.ResponseClass == ResponseClassError {
+		return nil, &ResponseError{ResponseCode: resp.ResponseCode, MessageText: resp.MessageText}
+	}
+
 	return &soapResp.Body.SendItemResponse, nil
 }
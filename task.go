@@ -0,0 +1,37 @@
+package ews
+
+import (
+	"time"
+)
+
+const (
+	TaskStatusNotStarted      = "NotStarted"
+	TaskStatusInProgress      = "InProgress"
+	TaskStatusCompleted       = "Completed"
+	TaskStatusWaitingOnOthers = "WaitingOnOthers"
+	TaskStatusDeferred        = "Deferred"
+)
+
+// Task fields follow the order of the EWS schema (ItemType, then TaskType)
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/task
+type Task struct {
+	MimeContent        *MimeContent       `xml:"http://schemas.microsoft.com/exchange/services/2006/types MimeContent,omitempty"`
+	ItemId             *ItemId            `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemId,omitempty"`
+	ParentFolderId     *FolderId          `xml:"http://schemas.microsoft.com/exchange/services/2006/types ParentFolderId,omitempty"`
+	ItemClass          *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemClass,omitempty"`
+	Subject            *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Subject,omitempty"`
+	Body               *Body              `xml:"http://schemas.microsoft.com/exchange/services/2006/types Body,omitempty"`
+	Categories         *Categories        `xml:"http://schemas.microsoft.com/exchange/services/2006/types Categories,omitempty"`
+	Importance         *string            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Importance,omitempty"`
+	ReminderDueBy      *time.Time         `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReminderDueBy,omitempty"`
+	ReminderIsSet      *bool              `xml:"http://schemas.microsoft.com/exchange/services/2006/types ReminderIsSet,omitempty"`
+	ExtendedProperties []ExtendedProperty `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedProperty,omitempty"`
+
+	CompleteDate    *time.Time `xml:"http://schemas.microsoft.com/exchange/services/2006/types CompleteDate,omitempty"`
+	DueDate         *time.Time `xml:"http://schemas.microsoft.com/exchange/services/2006/types DueDate,omitempty"`
+	IsComplete      *bool      `xml:"http://schemas.microsoft.com/exchange/services/2006/types IsComplete,omitempty"`
+	Owner           *string    `xml:"http://schemas.microsoft.com/exchange/services/2006/types Owner,omitempty"`
+	PercentComplete *float64   `xml:"http://schemas.microsoft.com/exchange/services/2006/types PercentComplete,omitempty"`
+	StartDate       *time.Time `xml:"http://schemas.microsoft.com/exchange/services/2006/types StartDate,omitempty"`
+	Status          *string    `xml:"http://schemas.microsoft.com/exchange/services/2006/types Status,omitempty"`
+}
@@ -0,0 +1,87 @@
+package ews
+
+import (
+	"encoding/xml"
+)
+
+type UpdateFolderRequest struct {
+	XMLName       struct{}      `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateFolder"`
+	FolderChanges FolderChanges `xml:"http://schemas.microsoft.com/exchange/services/2006/messages FolderChanges"`
+}
+
+type FolderChanges struct {
+	FolderChange []FolderChange `xml:"http://schemas.microsoft.com/exchange/services/2006/types FolderChange"`
+}
+
+// FolderChange targets the folder by either FolderId or DistinguishedFolderId
+type FolderChange struct {
+	TargetFolderId
+	Updates FolderUpdates `xml:"http://schemas.microsoft.com/exchange/services/2006/types Updates"`
+}
+
+type FolderUpdates struct {
+	SetFolderField      []SetFolderField    `xml:"http://schemas.microsoft.com/exchange/services/2006/types SetFolderField,omitempty"`
+	AppendToFolderField []SetFolderField    `xml:"http://schemas.microsoft.com/exchange/services/2006/types AppendToFolderField,omitempty"`
+	DeleteFolderField   []DeleteFolderField `xml:"http://schemas.microsoft.com/exchange/services/2006/types DeleteFolderField,omitempty"`
+}
+
+// SetFolderField holds the field path and a folder, of the matching type, carrying the new value
+type SetFolderField struct {
+	FieldURI         *FieldURI         `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
+	Folder           *Folder           `xml:"http://schemas.microsoft.com/exchange/services/2006/types Folder,omitempty"`
+	CalendarFolder   *CalendarFolder   `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarFolder,omitempty"`
+	ContactsFolder   *ContactsFolder   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ContactsFolder,omitempty"`
+	SearchFolder     *SearchFolder     `xml:"http://schemas.microsoft.com/exchange/services/2006/types SearchFolder,omitempty"`
+	TasksFolder      *TasksFolder      `xml:"http://schemas.microsoft.com/exchange/services/2006/types TasksFolder,omitempty"`
+}
+
+type DeleteFolderField struct {
+	FieldURI         *FieldURI         `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
+}
+
+type updateFolderResponseEnvelope struct {
+	XMLName xml.Name                 `xml:"Envelope"`
+	Body    updateFolderResponseBody `xml:"Body"`
+}
+
+type updateFolderResponseBody struct {
+	UpdateFolderResponse UpdateFolderResponse `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateFolderResponse"`
+}
+
+type UpdateFolderResponse struct {
+	ResponseMessages UpdateFolderResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
+}
+
+type UpdateFolderResponseMessages struct {
+	UpdateFolderResponseMessage []FolderInfoResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateFolderResponseMessage"`
+}
+
+// UpdateFolder applies the folder changes, the response holds the updated FolderId and ChangeKey
+// https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/updatefolder-operation
+func UpdateFolder(c Client, r *UpdateFolderRequest) (*UpdateFolderResponse, error) {
+	xmlBytes, err := xml.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	bb, err := c.SendAndReceive(xmlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var soapResp updateFolderResponseEnvelope
+	err = xml.Unmarshal(bb, &soapResp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range soapResp.Body.UpdateFolderResponse.ResponseMessages.UpdateFolderResponseMessage {
+		if err := resp.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &soapResp.Body.UpdateFolderResponse, nil
+}
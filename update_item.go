@@ -2,7 +2,6 @@ package ews
 
 import (
 	"encoding/xml"
-	"errors"
 )
 
 // UpdateItem
@@ -15,10 +14,13 @@ const (
 )
 
 type UpdateItemRequest struct {
-	XMLName            xml.Name            `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateItem"`
-	MessageDisposition MessageDisposition  `xml:"MessageDisposition,attr,omitempty"`
-	ConflictResolution *ConflictResolution `xml:"ConflictResolution,attr,omitempty"`
-	ItemChanges        ItemChanges         `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemChanges"`
+	XMLName              xml.Name            `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateItem"`
+	MessageDisposition   MessageDisposition  `xml:"MessageDisposition,attr,omitempty"`
+	ConflictResolution   *ConflictResolution `xml:"ConflictResolution,attr,omitempty"`
+	SuppressReadReceipts *bool               `xml:"SuppressReadReceipts,attr,omitempty"`
+	// SendMeetingInvitationsOrCancellations is required when updating calendar items
+	SendMeetingInvitationsOrCancellations SendMeetingInvitationsOrCancellations `xml:"SendMeetingInvitationsOrCancellations,attr,omitempty"`
+	ItemChanges                           ItemChanges                           `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ItemChanges"`
 }
 
 type MessageDisposition string
@@ -29,6 +31,16 @@ const (
 	MessageDispositionSendAndSaveCopy MessageDisposition = "SendAndSaveCopy"
 )
 
+type SendMeetingInvitationsOrCancellations string
+
+const (
+	SendMeetingInvitationsOrCancellationsSendToNone               SendMeetingInvitationsOrCancellations = "SendToNone"
+	SendMeetingInvitationsOrCancellationsSendOnlyToAll            SendMeetingInvitationsOrCancellations = "SendOnlyToAll"
+	SendMeetingInvitationsOrCancellationsSendOnlyToChanged        SendMeetingInvitationsOrCancellations = "SendOnlyToChanged"
+	SendMeetingInvitationsOrCancellationsSendToAllAndSaveCopy     SendMeetingInvitationsOrCancellations = "SendToAllAndSaveCopy"
+	SendMeetingInvitationsOrCancellationsSendToChangedAndSaveCopy SendMeetingInvitationsOrCancellations = "SendToChangedAndSaveCopy"
+)
+
 type ItemChanges struct {
 	ItemChange []ItemChange `xml:"http://schemas.microsoft.com/exchange/services/2006/types ItemChange"`
 }
@@ -44,19 +56,25 @@ type Updates struct {
 	DeleteItemField   []DeleteItemField   `xml:"http://schemas.microsoft.com/exchange/services/2006/types DeleteItemField"`
 }
 
-type AppendToItemField struct {
-	FieldURI FieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI"`
-	Message  Message  `xml:"http://schemas.microsoft.com/exchange/services/2006/types Message"`
-}
+// AppendToItemField carries one of the field paths and the item holding the values to append,
+// the item must be of the type of the updated item
+type AppendToItemField SetItemField
 
+// SetItemField carries one of the field paths and the item holding the new value,
+// the item must be of the type of the updated item
 type SetItemField struct {
-	FieldURI         *FieldURI         `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
-	ExtendedFieldURI *ExtendedFieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
-	Message          *Message          `xml:"http://schemas.microsoft.com/exchange/services/2006/types Message,omitempty"`
+	FieldURI         *FieldURI           `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	IndexedFieldURI  *IndexedFieldURI    `xml:"http://schemas.microsoft.com/exchange/services/2006/types IndexedFieldURI,omitempty"`
+	ExtendedFieldURI *ExtendedFieldURI   `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
+	Message          *Message            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Message,omitempty"`
+	CalendarItem     *CalendarItemUpdate `xml:"http://schemas.microsoft.com/exchange/services/2006/types CalendarItem,omitempty"`
+	Contact          *Contact            `xml:"http://schemas.microsoft.com/exchange/services/2006/types Contact,omitempty"`
+	Task             *Task               `xml:"http://schemas.microsoft.com/exchange/services/2006/types Task,omitempty"`
 }
 
 type DeleteItemField struct {
 	FieldURI         *FieldURI         `xml:"http://schemas.microsoft.com/exchange/services/2006/types FieldURI,omitempty"`
+	IndexedFieldURI  *IndexedFieldURI  `xml:"http://schemas.microsoft.com/exchange/services/2006/types IndexedFieldURI,omitempty"`
 	ExtendedFieldURI *ExtendedFieldURI `xml:"http://schemas.microsoft.com/exchange/services/2006/types ExtendedFieldURI,omitempty"`
 }
 
@@ -76,16 +94,27 @@ type UpdateItemResponse struct {
 	ResponseMessages UpdateItemResponseMessages `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseMessages"`
 }
 
+// UpdateItemResponseMessages holds one response message per item change, in the order of the request
 type UpdateItemResponseMessages struct {
-	UpdateItemResponseMessage UpdateItemResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateItemResponseMessage"`
+	UpdateItemResponseMessage []UpdateItemResponseMessage `xml:"http://schemas.microsoft.com/exchange/services/2006/messages UpdateItemResponseMessage"`
 }
 
+// UpdateItemResponseMessage returns the updated item with its new ChangeKey
 type UpdateItemResponseMessage struct {
-	ResponseClass ResponseClass `xml:"ResponseClass,attr"`
-	ResponseCode  string        `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseCode"`
+	ResponseClass   ResponseClass   `xml:"ResponseClass,attr"`
+	MessageText     string          `xml:"http://schemas.microsoft.com/exchange/services/2006/messages MessageText"`
+	ResponseCode    string          `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ResponseCode"`
+	Items           Items           `xml:"http://schemas.microsoft.com/exchange/services/2006/messages Items"`
+	ConflictResults ConflictResults `xml:"http://schemas.microsoft.com/exchange/services/2006/messages ConflictResults"`
+}
+
+// ConflictResults counts the changes that were not applied because of a conflict
+type ConflictResults struct {
+	Count int `xml:"http://schemas.microsoft.com/exchange/services/2006/types Count"`
 }
 
-// UpdateItem takes an UpdateItem request and returns an UpdateItemResponse.
+// UpdateItem takes an UpdateItem request and returns an UpdateItemResponse,
+// or the error of the first item change that failed.
 // https://docs.microsoft.com/en-us/exchange/client-developer/web-service-reference/updateitem-operation
 func UpdateItem(c Client, r *UpdateItemRequest) (*UpdateItemResponse, error) {
 	xmlBytes, err := xml.MarshalIndent(r, "", "  ")
@@ -104,8 +133,10 @@ func UpdateItem(c Client, r *UpdateItemRequest) (*UpdateItemResponse, error) {
 		return nil, err
 	}
 
-	if ResponseClass(soapResp.Body.UpdateItemResponse.ResponseMessages.UpdateItemResponseMessage.ResponseClass) == ResponseClassError {
-		return nil, errors.New(soapResp.Body.UpdateItemResponse.ResponseMessages.UpdateItemResponseMessage.ResponseCode)
+	for _, resp := range soapResp.Body.UpdateItemResponse.ResponseMessages.UpdateItemResponseMessage {
+		if resp.ResponseClass == ResponseClassError {
+			return nil, &ResponseError{ResponseCode: resp.ResponseCode, MessageText: resp.MessageText}
+		}
 	}
 
 	return &soapResp.Body.UpdateItemResponse, nil
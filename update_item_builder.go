@@ -0,0 +1,309 @@
+package ews
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ItemType is the type of the item an UpdateItemBuilder change applies to
+type ItemType string
+
+const (
+	ItemTypeMessage      ItemType = "Message"
+	ItemTypeCalendarItem ItemType = "CalendarItem"
+	ItemTypeContact      ItemType = "Contact"
+	ItemTypeTask         ItemType = "Task"
+)
+
+// indexedFieldElements maps the indexed field URIs to the dictionary element of Contact
+var indexedFieldElements = map[string]string{
+	"contacts:EmailAddress": "EmailAddresses",
+	"contacts:PhoneNumber":  "PhoneNumbers",
+	"contacts:ImAddress":    "ImAddresses",
+}
+
+// UpdateItemBuilder assembles an UpdateItem request one item change at a time:
+//
+//	req, err := NewUpdateItemBuilder().
+//		Item(eventId, ItemTypeCalendarItem).
+//		Set("calendar:Location", "Room 4").
+//		Delete("item:Categories").
+//		Item(messageId, ItemTypeMessage).
+//		Set("message:IsRead", true).
+//		Build()
+//
+// Set and Append take the value of the element named after the field URI, ex: a bool or *bool
+// for message:IsRead, a time.Time for calendar:Start, a *Categories for item:Categories.
+// The first error is kept and returned by Build.
+type UpdateItemBuilder struct {
+	conflictResolution                    ConflictResolution
+	messageDisposition                    MessageDisposition
+	sendMeetingInvitationsOrCancellations SendMeetingInvitationsOrCancellations
+	suppressReadReceipts                  *bool
+
+	changes   []ItemChange
+	itemTypes []ItemType
+	err       error
+}
+
+func NewUpdateItemBuilder() *UpdateItemBuilder {
+	return &UpdateItemBuilder{
+		conflictResolution: ConflictResolutionAutoResolve,
+	}
+}
+
+// Item starts the changes of an item, pass the ChangeKey in itemId to detect concurrent updates
+func (b *UpdateItemBuilder) Item(itemId ItemId, itemType ItemType) *UpdateItemBuilder {
+	switch itemType {
+	case ItemTypeMessage, ItemTypeCalendarItem, ItemTypeContact, ItemTypeTask:
+	default:
+		b.fail(fmt.Errorf("unsupported item type %q", itemType))
+	}
+	b.changes = append(b.changes, ItemChange{ItemId: itemId})
+	b.itemTypes = append(b.itemTypes, itemType)
+	return b
+}
+
+// Set replaces the value of a property of the current item
+func (b *UpdateItemBuilder) Set(fieldURI string, value any) *UpdateItemBuilder {
+	field, err := b.newItemField(elementName(fieldURI), value)
+	if err != nil {
+		return b.fail(fmt.Errorf("set %s: %w", fieldURI, err))
+	}
+	field.FieldURI = &FieldURI{FieldURI: fieldURI}
+	return b.update(func(u *Updates) { u.SetItemField = append(u.SetItemField, *field) })
+}
+
+// Append adds values to a property of the current item, ex: recipients, attendees or the body
+func (b *UpdateItemBuilder) Append(fieldURI string, value any) *UpdateItemBuilder {
+	field, err := b.newItemField(elementName(fieldURI), value)
+	if err != nil {
+		return b.fail(fmt.Errorf("append %s: %w", fieldURI, err))
+	}
+	field.FieldURI = &FieldURI{FieldURI: fieldURI}
+	return b.update(func(u *Updates) { u.AppendToItemField = append(u.AppendToItemField, AppendToItemField(*field)) })
+}
+
+// Delete removes a property of the current item
+func (b *UpdateItemBuilder) Delete(fieldURI string) *UpdateItemBuilder {
+	return b.update(func(u *Updates) {
+		u.DeleteItemField = append(u.DeleteItemField, DeleteItemField{FieldURI: &FieldURI{FieldURI: fieldURI}})
+	})
+}
+
+// SetExtended replaces the value of an extended MAPI property of the current item
+func (b *UpdateItemBuilder) SetExtended(extendedFieldURI ExtendedFieldURI, value string) *UpdateItemBuilder {
+	field, err := b.newItemField("ExtendedProperty", []ExtendedProperty{{ExtendedFieldURI: &extendedFieldURI, Value: &value}})
+	if err != nil {
+		return b.fail(fmt.Errorf("set extended property: %w", err))
+	}
+	field.ExtendedFieldURI = &extendedFieldURI
+	return b.update(func(u *Updates) { u.SetItemField = append(u.SetItemField, *field) })
+}
+
+// DeleteExtended removes an extended MAPI property of the current item
+func (b *UpdateItemBuilder) DeleteExtended(extendedFieldURI ExtendedFieldURI) *UpdateItemBuilder {
+	return b.update(func(u *Updates) {
+		u.DeleteItemField = append(u.DeleteItemField, DeleteItemField{ExtendedFieldURI: &extendedFieldURI})
+	})
+}
+
+// SetIndexed replaces one entry of a contact dictionary, ex: SetIndexed("contacts:EmailAddress", "EmailAddress1", "jane@contoso.com")
+func (b *UpdateItemBuilder) SetIndexed(fieldURI, fieldIndex, value string) *UpdateItemBuilder {
+	element, ok := indexedFieldElements[fieldURI]
+	if !ok {
+		return b.fail(fmt.Errorf("set %s: unsupported indexed field", fieldURI))
+	}
+	field, err := b.newItemField(element, &EntryDictionary{Entry: []DictionaryEntry{{Key: fieldIndex, Value: value}}})
+	if err != nil {
+		return b.fail(fmt.Errorf("set %s: %w", fieldURI, err))
+	}
+	field.IndexedFieldURI = &IndexedFieldURI{FieldURI: fieldURI, FieldIndex: fieldIndex}
+	return b.update(func(u *Updates) { u.SetItemField = append(u.SetItemField, *field) })
+}
+
+// DeleteIndexed removes one entry of a contact dictionary
+func (b *UpdateItemBuilder) DeleteIndexed(fieldURI, fieldIndex string) *UpdateItemBuilder {
+	return b.update(func(u *Updates) {
+		u.DeleteItemField = append(u.DeleteItemField, DeleteItemField{IndexedFieldURI: &IndexedFieldURI{FieldURI: fieldURI, FieldIndex: fieldIndex}})
+	})
+}
+
+// ConflictResolution defaults to AutoResolve
+func (b *UpdateItemBuilder) ConflictResolution(conflictResolution ConflictResolution) *UpdateItemBuilder {
+	b.conflictResolution = conflictResolution
+	return b
+}
+
+// MessageDisposition defaults to SaveOnly when messages are updated
+func (b *UpdateItemBuilder) MessageDisposition(messageDisposition MessageDisposition) *UpdateItemBuilder {
+	b.messageDisposition = messageDisposition
+	return b
+}
+
+// SendMeetingInvitationsOrCancellations defaults to SendToAllAndSaveCopy when calendar items are updated
+func (b *UpdateItemBuilder) SendMeetingInvitationsOrCancellations(send SendMeetingInvitationsOrCancellations) *UpdateItemBuilder {
+	b.sendMeetingInvitationsOrCancellations = send
+	return b
+}
+
+func (b *UpdateItemBuilder) SuppressReadReceipts(suppress bool) *UpdateItemBuilder {
+	b.suppressReadReceipts = &suppress
+	return b
+}
+
+// Build returns the UpdateItem request, or the first error met while adding the changes
+func (b *UpdateItemBuilder) Build() (*UpdateItemRequest, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.changes) == 0 {
+		return nil, errors.New("no item to update")
+	}
+
+	r := &UpdateItemRequest{
+		ConflictResolution:                    &b.conflictResolution,
+		SuppressReadReceipts:                  b.suppressReadReceipts,
+		MessageDisposition:                    b.messageDisposition,
+		SendMeetingInvitationsOrCancellations: b.sendMeetingInvitationsOrCancellations,
+		ItemChanges:                           ItemChanges{ItemChange: b.changes},
+	}
+	for i, change := range b.changes {
+		u := change.Updates
+		if len(u.SetItemField)+len(u.AppendToItemField)+len(u.DeleteItemField) == 0 {
+			return nil, fmt.Errorf("no change for item %s", change.ItemId.Id)
+		}
+		switch b.itemTypes[i] {
+		case ItemTypeMessage:
+			if r.MessageDisposition == "" {
+				r.MessageDisposition = MessageDispositionSaveOnly
+			}
+		case ItemTypeCalendarItem:
+			if r.SendMeetingInvitationsOrCancellations == "" {
+				r.SendMeetingInvitationsOrCancellations = SendMeetingInvitationsOrCancellationsSendToAllAndSaveCopy
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Send builds and sends the request, and returns the updated item ids carrying their new ChangeKey,
+// one per item change in the order of Item calls, nil when the server left the item out of the response
+func (b *UpdateItemBuilder) Send(c Client) ([]*ItemId, error) {
+	r, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := UpdateItem(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	itemIds := make([]*ItemId, len(resp.ResponseMessages.UpdateItemResponseMessage))
+	for i, message := range resp.ResponseMessages.UpdateItemResponseMessage {
+		if ids := message.Items.ItemIds(); len(ids) > 0 {
+			itemIds[i] = &ids[0]
+		}
+	}
+	return itemIds, nil
+}
+
+func (b *UpdateItemBuilder) fail(err error) *UpdateItemBuilder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
+
+func (b *UpdateItemBuilder) update(f func(u *Updates)) *UpdateItemBuilder {
+	if len(b.changes) == 0 {
+		return b.fail(errors.New("no item to update, call Item first"))
+	}
+	f(&b.changes[len(b.changes)-1].Updates)
+	return b
+}
+
+// newItemField returns an item field holding an item of the current type with the element set to value
+func (b *UpdateItemBuilder) newItemField(element string, value any) (*SetItemField, error) {
+	if len(b.itemTypes) == 0 {
+		return nil, errors.New("no item to update, call Item first")
+	}
+
+	field := &SetItemField{}
+	var item any
+	switch b.itemTypes[len(b.itemTypes)-1] {
+	case ItemTypeMessage:
+		field.Message = &Message{}
+		item = field.Message
+	case ItemTypeCalendarItem:
+		field.CalendarItem = &CalendarItemUpdate{}
+		item = field.CalendarItem
+	case ItemTypeContact:
+		field.Contact = &Contact{}
+		item = field.Contact
+	case ItemTypeTask:
+		field.Task = &Task{}
+		item = field.Task
+	}
+
+	if err := setElement(reflect.ValueOf(item).Elem(), element, value); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// setElement sets the field of item marshalled as element, wrapping value in a pointer when needed
+func setElement(item reflect.Value, element string, value any) error {
+	for i := 0; i < item.NumField(); i++ {
+		if xmlElementName(item.Type().Field(i)) != element {
+			continue
+		}
+
+		field := item.Field(i)
+		v := reflect.ValueOf(value)
+		if !v.IsValid() {
+			return errors.New("nil value, use Delete to remove a property")
+		}
+
+		switch t := field.Type(); {
+		case v.Type().AssignableTo(t):
+			field.Set(v)
+		case t.Kind() == reflect.Pointer && v.Type().AssignableTo(t.Elem()):
+			field.Set(reflect.New(t.Elem()))
+			field.Elem().Set(v)
+		case t.Kind() == reflect.Pointer && v.Kind() == t.Elem().Kind() && v.Type().ConvertibleTo(t.Elem()):
+			// named string types, ex: FlagStatus for a *string
+			field.Set(reflect.New(t.Elem()))
+			field.Elem().Set(v.Convert(t.Elem()))
+		case v.Kind() == t.Kind() && v.Type().ConvertibleTo(t):
+			field.Set(v.Convert(t))
+		default:
+			return fmt.Errorf("%s expects a %s, got %s", element, t, v.Type())
+		}
+
+		if field.Kind() != reflect.Pointer && field.IsZero() {
+			return errors.New("empty value, use Delete to remove a property")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no %s element on %s", element, item.Type().Name())
+}
+
+// elementName returns the element set by a field URI, ex: Subject for item:Subject
+func elementName(fieldURI string) string {
+	return fieldURI[strings.Index(fieldURI, ":")+1:]
+}
+
+// xmlElementName returns the local name in the xml tag of a struct field
+func xmlElementName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("xml"), ",")
+	if i := strings.LastIndex(name, " "); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
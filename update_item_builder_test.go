@@ -0,0 +1,175 @@
+package ews
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UpdateItemBuilder(t *testing.T) {
+	start := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	req, err := NewUpdateItemBuilder().
+		Item(ItemId{Id: "AAMkEvent", ChangeKey: "DwAAAA"}, ItemTypeCalendarItem).
+		Set("calendar:Start", start).
+		Set("calendar:Location", "Room 4").
+		Item(ItemId{Id: "AAMkContact"}, ItemTypeContact).
+		SetIndexed("contacts:EmailAddress", "EmailAddress1", "jane@contoso.com").
+		DeleteIndexed("contacts:PhoneNumber", "MobilePhone").
+		Item(ItemId{Id: "AAMkTask"}, ItemTypeTask).
+		Set("task:Status", TaskStatusCompleted).
+		SetExtended(ExtendedFieldURI{PropertyTag: "0x7d01", PropertyType: PropertyTypeString}, "done").
+		Build()
+	require.NoError(t, err)
+
+	xmlBytes, err := xml.MarshalIndent(req, "", "  ")
+	require.NoError(t, err)
+
+	assert.Equal(t, `<UpdateItem xmlns="http://schemas.microsoft.com/exchange/services/2006/messages" ConflictResolution="AutoResolve" SendMeetingInvitationsOrCancellations="SendToAllAndSaveCopy">
+  <ItemChanges xmlns="http://schemas.microsoft.com/exchange/services/2006/messages">
+    <ItemChange xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkEvent" ChangeKey="DwAAAA"></ItemId>
+      <Updates xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <SetItemField xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="calendar:Start"></FieldURI>
+          <CalendarItem xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+            <Start xmlns="http://schemas.microsoft.com/exchange/services/2006/types">2025-03-04T09:00:00Z</Start>
+          </CalendarItem>
+        </SetItemField>
+        <SetItemField xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="calendar:Location"></FieldURI>
+          <CalendarItem xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+            <Location xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Room 4</Location>
+          </CalendarItem>
+        </SetItemField>
+      </Updates>
+    </ItemChange>
+    <ItemChange xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkContact"></ItemId>
+      <Updates xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <SetItemField xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <IndexedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="contacts:EmailAddress" FieldIndex="EmailAddress1"></IndexedFieldURI>
+          <Contact xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+            <EmailAddresses xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+              <Entry xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Key="EmailAddress1">jane@contoso.com</Entry>
+            </EmailAddresses>
+          </Contact>
+        </SetItemField>
+        <DeleteItemField xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <IndexedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="contacts:PhoneNumber" FieldIndex="MobilePhone"></IndexedFieldURI>
+        </DeleteItemField>
+      </Updates>
+    </ItemChange>
+    <ItemChange xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+      <ItemId xmlns="http://schemas.microsoft.com/exchange/services/2006/types" Id="AAMkTask"></ItemId>
+      <Updates xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+        <SetItemField xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <FieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" FieldURI="task:Status"></FieldURI>
+          <Task xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+            <Status xmlns="http://schemas.microsoft.com/exchange/services/2006/types">Completed</Status>
+          </Task>
+        </SetItemField>
+        <SetItemField xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+          <ExtendedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" PropertyTag="0x7d01" PropertyType="String"></ExtendedFieldURI>
+          <Task xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+            <ExtendedProperty xmlns="http://schemas.microsoft.com/exchange/services/2006/types">
+              <ExtendedFieldURI xmlns="http://schemas.microsoft.com/exchange/services/2006/types" PropertyTag="0x7d01" PropertyType="String"></ExtendedFieldURI>
+              <Value xmlns="http://schemas.microsoft.com/exchange/services/2006/types">done</Value>
+            </ExtendedProperty>
+          </Task>
+        </SetItemField>
+      </Updates>
+    </ItemChange>
+  </ItemChanges>
+</UpdateItem>`, string(xmlBytes))
+}
+
+func Test_UpdateItemBuilder_errors(t *testing.T) {
+	_, err := NewUpdateItemBuilder().Item(ItemId{Id: "AAMkMessage"}, ItemTypeMessage).Set("message:IsRead", "yes").Build()
+	assert.EqualError(t, err, "set message:IsRead: IsRead expects a *bool, got string")
+
+	_, err = NewUpdateItemBuilder().Item(ItemId{Id: "AAMkMessage"}, ItemTypeMessage).Set("calendar:Location", "Room 4").Build()
+	assert.EqualError(t, err, "set calendar:Location: no Location element on Message")
+
+	_, err = NewUpdateItemBuilder().Item(ItemId{Id: "AAMkEvent"}, ItemTypeCalendarItem).Set("item:Subject", "").Build()
+	assert.EqualError(t, err, "set item:Subject: empty value, use Delete to remove a property")
+
+	req, err := NewUpdateItemBuilder().Item(ItemId{Id: "AAMkMessage"}, ItemTypeMessage).Set("message:IsRead", false).Build()
+	require.NoError(t, err)
+	assert.Equal(t, MessageDispositionSaveOnly, req.MessageDisposition)
+	assert.False(t, *req.ItemChanges.ItemChange[0].Updates.SetItemField[0].Message.IsRead)
+}
+
+func Test_unmarshal_UpdateItemResponse(t *testing.T) {
+	soapResp := `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:UpdateItemResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:UpdateItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Items>
+            <t:CalendarItem>
+              <t:ItemId Id="AAMkEvent" ChangeKey="DwAAAB" />
+            </t:CalendarItem>
+          </m:Items>
+          <m:ConflictResults>
+            <t:Count>0</t:Count>
+          </m:ConflictResults>
+        </m:UpdateItemResponseMessage>
+      </m:ResponseMessages>
+    </m:UpdateItemResponse>
+  </s:Body>
+</s:Envelope>`
+
+	var resp UpdateItemResponseEnvelope
+	require.NoError(t, xml.Unmarshal([]byte(soapResp), &resp))
+
+	messages := resp.Body.UpdateItemResponse.ResponseMessages.UpdateItemResponseMessage
+	require.Len(t, messages, 1)
+	assert.Equal(t, []ItemId{{Id: "AAMkEvent", ChangeKey: "DwAAAB"}}, messages[0].Items.ItemIds())
+}
+
+type updateItemClient struct {
+	response string
+}
+
+func (c updateItemClient) SendAndReceive([]byte) ([]byte, error) { return []byte(c.response), nil }
+func (c updateItemClient) GetEWSAddr() string                    { return "https://outlook.contoso.com/EWS/Exchange.asmx" }
+func (c updateItemClient) GetUsername() string                   { return "jane@contoso.com" }
+
+func Test_UpdateItemBuilder_Send(t *testing.T) {
+	c := updateItemClient{response: `<?xml version="1.0" encoding="utf-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+  <s:Body>
+    <m:UpdateItemResponse xmlns:m="http://schemas.microsoft.com/exchange/services/2006/messages" xmlns:t="http://schemas.microsoft.com/exchange/services/2006/types">
+      <m:ResponseMessages>
+        <m:UpdateItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Items />
+        </m:UpdateItemResponseMessage>
+        <m:UpdateItemResponseMessage ResponseClass="Success">
+          <m:ResponseCode>NoError</m:ResponseCode>
+          <m:Items>
+            <t:CalendarItem>
+              <t:ItemId Id="AAMkEvent" ChangeKey="DwAAAB" />
+            </t:CalendarItem>
+          </m:Items>
+        </m:UpdateItemResponseMessage>
+      </m:ResponseMessages>
+    </m:UpdateItemResponse>
+  </s:Body>
+</s:Envelope>`}
+
+	itemIds, err := NewUpdateItemBuilder().
+		Item(ItemId{Id: "AAMkMessage", ChangeKey: "CQAAAA"}, ItemTypeMessage).
+		Set("message:IsRead", true).
+		Item(ItemId{Id: "AAMkEvent", ChangeKey: "DwAAAA"}, ItemTypeCalendarItem).
+		Set("calendar:Location", "Room 4").
+		Send(c)
+	require.NoError(t, err)
+	assert.Equal(t, []*ItemId{nil, {Id: "AAMkEvent", ChangeKey: "DwAAAB"}}, itemIds)
+}